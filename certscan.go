@@ -0,0 +1,380 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package gofish
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/rocksolidlabs/gofish/redfish"
+)
+
+// CertificateIssue is a problem found with a certificate.
+type CertificateIssue string
+
+const (
+	// ExpiredCertificateIssue indicates the certificate is no longer valid.
+	ExpiredCertificateIssue CertificateIssue = "Expired"
+	// ExpiringCertificateIssue indicates the certificate expires within the
+	// scan threshold.
+	ExpiringCertificateIssue CertificateIssue = "Expiring"
+	// WeakKeyCertificateIssue indicates the public key is smaller than the
+	// minimum size for its algorithm.
+	WeakKeyCertificateIssue CertificateIssue = "WeakKey"
+	// SelfSignedCertificateIssue indicates the certificate is signed by its
+	// own key.
+	SelfSignedCertificateIssue CertificateIssue = "SelfSigned"
+	// MismatchCertificateIssue indicates the certificate served for TLS is
+	// not one the service reports for HTTPS. It is only checked if the
+	// service reports its HTTPS certificates.
+	MismatchCertificateIssue CertificateIssue = "Mismatch"
+	// UnparseableCertificateIssue indicates the certificate string could not
+	// be decoded, so only the properties reported by the service were
+	// checked.
+	UnparseableCertificateIssue CertificateIssue = "Unparseable"
+)
+
+// CertificateScanOptions controls what a certificate scan considers an
+// issue. Zero values select the defaults.
+type CertificateScanOptions struct {
+	// ExpiryThreshold is how far ahead to report expiring certificates. The
+	// default is 30 days.
+	ExpiryThreshold time.Duration
+	// MinRSAKeyBits is the smallest acceptable RSA key. The default is 2048.
+	MinRSAKeyBits int
+	// MinECDSAKeyBits is the smallest acceptable ECDSA key. The default is
+	// 256.
+	MinECDSAKeyBits int
+	// DialTimeout limits the TLS handshake with the endpoint. The default is
+	// 10 seconds.
+	DialTimeout time.Duration
+	// Now is the time the certificates are checked at. The default is the
+	// current time.
+	Now time.Time
+}
+
+func (options CertificateScanOptions) withDefaults() CertificateScanOptions {
+	if options.ExpiryThreshold == 0 {
+		options.ExpiryThreshold = 30 * 24 * time.Hour
+	}
+	if options.MinRSAKeyBits == 0 {
+		options.MinRSAKeyBits = 2048
+	}
+	if options.MinECDSAKeyBits == 0 {
+		options.MinECDSAKeyBits = 256
+	}
+	if options.DialTimeout == 0 {
+		options.DialTimeout = 10 * time.Second
+	}
+	if options.Now.IsZero() {
+		options.Now = time.Now()
+	}
+	return options
+}
+
+// CertificateFinding is the result of checking a single certificate.
+type CertificateFinding struct {
+	// ODataID is the location of the certificate on the service, empty for
+	// the certificate served during the TLS handshake.
+	ODataID string `json:"@odata.id,omitempty"`
+	// Subject is the distinguished name of the certificate subject.
+	Subject string
+	// Issuer is the distinguished name of the certificate issuer.
+	Issuer string
+	// SerialNumber is the serial number of the certificate.
+	SerialNumber string `json:",omitempty"`
+	// NotAfter is the end of the validity period.
+	NotAfter time.Time
+	// KeyAlgorithm is the public key algorithm.
+	KeyAlgorithm string `json:",omitempty"`
+	// KeyBits is the size of the public key.
+	KeyBits int `json:",omitempty"`
+	// Issues are the problems found with the certificate.
+	Issues []CertificateIssue `json:",omitempty"`
+}
+
+// CertificateScanReport is the result of scanning the certificates of a
+// single endpoint.
+type CertificateScanReport struct {
+	// Endpoint is the URL of the service that was scanned.
+	Endpoint string
+	// ScannedAt is the time the certificates were checked at.
+	ScannedAt time.Time
+	// Certificates are the certificates reported by the service.
+	Certificates []CertificateFinding
+	// Served is the certificate presented by the endpoint during the TLS
+	// handshake. It is nil for plain HTTP endpoints.
+	Served *CertificateFinding `json:",omitempty"`
+	// Error is set if the scan could not be completed.
+	Error string `json:",omitempty"`
+}
+
+// HasIssues indicates whether any issues were found, or the scan failed.
+func (report *CertificateScanReport) HasIssues() bool {
+	if report.Error != "" {
+		return true
+	}
+	if report.Served != nil && len(report.Served.Issues) > 0 {
+		return true
+	}
+	for _, finding := range report.Certificates {
+		if len(finding.Issues) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ScanCertificates checks the certificates installed on the service
+// reachable through the client, and the certificate it serves for TLS.
+func ScanCertificates(client *ApiClient, options CertificateScanOptions) (*CertificateScanReport, error) {
+	options = options.withDefaults()
+	report := &CertificateScanReport{
+		Endpoint:  client.Endpoint,
+		ScannedAt: options.Now,
+	}
+
+	service, err := ServiceRoot(client)
+	if err != nil {
+		return nil, err
+	}
+
+	if service.certificateService != "" {
+		certificateService, err := service.CertificateService()
+		if err != nil {
+			return nil, err
+		}
+
+		certificates, err := certificateService.CertificateLocations()
+		if err != nil {
+			return nil, err
+		}
+		for _, certificate := range certificates {
+			report.Certificates = append(report.Certificates, checkReportedCertificate(certificate, options))
+		}
+	}
+
+	served, err := servedCertificate(client.Endpoint, options.DialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if served == nil {
+		return report, nil
+	}
+
+	finding := checkCertificate(served, options)
+	report.Served = &finding
+
+	reported, err := reportedHTTPSCertificates(service)
+	if err != nil {
+		return nil, err
+	}
+	matched := false
+	for _, certificate := range reported {
+		parsed := parseCertificateString(certificate.CertificateString)
+		if parsed != nil && bytes.Equal(parsed.Raw, served.Raw) {
+			matched = true
+			break
+		}
+	}
+	if len(reported) > 0 && !matched {
+		report.Served.Issues = append(report.Served.Issues, MismatchCertificateIssue)
+	}
+
+	return report, nil
+}
+
+// ScanFleetCertificates scans the certificates of each client, running at
+// most concurrency scans at once. Scans that fail are reported with their
+// Error set rather than stopping the others.
+func ScanFleetCertificates(clients []*ApiClient, options CertificateScanOptions, concurrency int) []*CertificateScanReport {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	options = options.withDefaults()
+
+	reports := make([]*CertificateScanReport, len(clients))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, client := range clients {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, client *ApiClient) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			report, err := ScanCertificates(client, options)
+			if err != nil {
+				report = &CertificateScanReport{
+					Endpoint:  client.Endpoint,
+					ScannedAt: options.Now,
+					Error:     err.Error(),
+				}
+			}
+			reports[i] = report
+		}(i, client)
+	}
+
+	wg.Wait()
+	return reports
+}
+
+// reportedHTTPSCertificates gets the certificates the managers of the
+// service report using for HTTPS.
+func reportedHTTPSCertificates(service *Service) ([]*redfish.Certificate, error) {
+	var result []*redfish.Certificate
+	if service.managers == "" {
+		return result, nil
+	}
+
+	managers, err := service.Managers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, manager := range managers {
+		protocol, err := manager.NetworkProtocol()
+		if err != nil {
+			return nil, err
+		}
+		if protocol == nil {
+			continue
+		}
+
+		certificates, err := protocol.HTTPSCertificates()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, certificates...)
+	}
+
+	return result, nil
+}
+
+// servedCertificate gets the leaf certificate presented by the endpoint.
+func servedCertificate(endpoint string, timeout time.Duration) (*x509.Certificate, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" {
+		return nil, nil
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "443")
+	}
+
+	// The certificate is being inspected, not trusted, so it is not verified.
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", host, &tls.Config{
+		InsecureSkipVerify: true,
+		ServerName:         u.Hostname(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	certificates := conn.ConnectionState().PeerCertificates
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("no certificate presented by %s", host)
+	}
+	return certificates[0], nil
+}
+
+// checkReportedCertificate checks a certificate reported by the service,
+// falling back to its reported properties if the certificate string cannot
+// be decoded.
+func checkReportedCertificate(certificate *redfish.Certificate, options CertificateScanOptions) CertificateFinding {
+	if parsed := parseCertificateString(certificate.CertificateString); parsed != nil {
+		finding := checkCertificate(parsed, options)
+		finding.ODataID = certificate.ODataID
+		return finding
+	}
+
+	finding := CertificateFinding{
+		ODataID:      certificate.ODataID,
+		Subject:      certificate.Subject.CommonName,
+		Issuer:       certificate.Issuer.CommonName,
+		SerialNumber: certificate.SerialNumber,
+		Issues:       []CertificateIssue{UnparseableCertificateIssue},
+	}
+	if notAfter, err := time.Parse(time.RFC3339, certificate.ValidNotAfter); err == nil {
+		finding.NotAfter = notAfter
+		finding.Issues = append(finding.Issues, expiryIssues(notAfter, options)...)
+	}
+	return finding
+}
+
+// parseCertificateString decodes the first certificate of a PEM encoded
+// certificate string, returning nil if there is none.
+func parseCertificateString(s string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	return certificate
+}
+
+// checkCertificate checks a decoded certificate.
+func checkCertificate(certificate *x509.Certificate, options CertificateScanOptions) CertificateFinding {
+	finding := CertificateFinding{
+		Subject:      certificate.Subject.String(),
+		Issuer:       certificate.Issuer.String(),
+		SerialNumber: certificate.SerialNumber.String(),
+		NotAfter:     certificate.NotAfter,
+		KeyAlgorithm: certificate.PublicKeyAlgorithm.String(),
+	}
+
+	finding.Issues = append(finding.Issues, expiryIssues(certificate.NotAfter, options)...)
+
+	minimum := 0
+	switch key := certificate.PublicKey.(type) {
+	case *rsa.PublicKey:
+		finding.KeyBits = key.N.BitLen()
+		minimum = options.MinRSAKeyBits
+	case *ecdsa.PublicKey:
+		finding.KeyBits = key.Curve.Params().BitSize
+		minimum = options.MinECDSAKeyBits
+	case ed25519.PublicKey:
+		finding.KeyBits = 256
+	}
+	if finding.KeyBits < minimum {
+		finding.Issues = append(finding.Issues, WeakKeyCertificateIssue)
+	}
+
+	if bytes.Equal(certificate.RawIssuer, certificate.RawSubject) && certificate.CheckSignature(certificate.SignatureAlgorithm, certificate.RawTBSCertificate, certificate.Signature) == nil {
+		finding.Issues = append(finding.Issues, SelfSignedCertificateIssue)
+	}
+
+	return finding
+}
+
+func expiryIssues(notAfter time.Time, options CertificateScanOptions) []CertificateIssue {
+	if options.Now.After(notAfter) {
+		return []CertificateIssue{ExpiredCertificateIssue}
+	}
+	if options.Now.Add(options.ExpiryThreshold).After(notAfter) {
+		return []CertificateIssue{ExpiringCertificateIssue}
+	}
+	return nil
+}
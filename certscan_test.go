@@ -0,0 +1,140 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package gofish
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// selfSignedCertificate creates a PEM encoded self-signed certificate.
+func selfSignedCertificate(t *testing.T, bits int, notAfter time.Time) string {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		t.Fatalf("Error generating key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "bmc.example.com"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Error creating certificate: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// TestScanCertificates tests scanning the certificates of a service.
+func TestScanCertificates(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	certificate := selfSignedCertificate(t, 1024, now.Add(10*24*time.Hour))
+	certificateBody, _ := json.Marshal(map[string]interface{}{
+		"@odata.id":         "/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates/1",
+		"Id":                "1",
+		"CertificateString": certificate,
+		"CertificateType":   "PEM",
+	})
+
+	responses := map[string]string{
+		"/redfish/v1/": `{
+			"CertificateService": {"@odata.id": "/redfish/v1/CertificateService"},
+			"Managers": {"@odata.id": "/redfish/v1/Managers"}
+		}`,
+		"/redfish/v1/CertificateService": `{
+			"CertificateLocations": {"@odata.id": "/redfish/v1/CertificateService/CertificateLocations"}
+		}`,
+		"/redfish/v1/CertificateService/CertificateLocations": `{
+			"Links": {
+				"Certificates": [
+					{"@odata.id": "/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates/1"}
+				]
+			}
+		}`,
+		"/redfish/v1/Managers": `{
+			"Members@odata.count": 1,
+			"Members": [{"@odata.id": "/redfish/v1/Managers/BMC"}]
+		}`,
+		"/redfish/v1/Managers/BMC": `{
+			"Id": "BMC",
+			"NetworkProtocol": {"@odata.id": "/redfish/v1/Managers/BMC/NetworkProtocol"}
+		}`,
+		"/redfish/v1/Managers/BMC/NetworkProtocol": `{
+			"HTTPS": {
+				"Certificates": {"@odata.id": "/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates"}
+			}
+		}`,
+		"/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates": `{
+			"Members@odata.count": 1,
+			"Members": [{"@odata.id": "/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates/1"}]
+		}`,
+		"/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates/1": string(certificateBody),
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	client, err := APIClient(server.URL, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	report, err := ScanCertificates(client, CertificateScanOptions{Now: now})
+	if err != nil {
+		t.Fatalf("Error scanning certificates: %s", err)
+	}
+
+	if len(report.Certificates) != 1 {
+		t.Fatalf("Expected 1 certificate, got %d", len(report.Certificates))
+	}
+
+	finding := report.Certificates[0]
+	if finding.KeyBits != 1024 {
+		t.Errorf("Invalid key size: %d", finding.KeyBits)
+	}
+
+	expected := []CertificateIssue{ExpiringCertificateIssue, WeakKeyCertificateIssue, SelfSignedCertificateIssue}
+	if fmt.Sprint(finding.Issues) != fmt.Sprint(expected) {
+		t.Errorf("Expected issues %v, got %v", expected, finding.Issues)
+	}
+
+	if report.Served == nil {
+		t.Fatal("Expected the served certificate to be checked")
+	}
+
+	mismatch := false
+	for _, issue := range report.Served.Issues {
+		if issue == MismatchCertificateIssue {
+			mismatch = true
+		}
+	}
+	if !mismatch {
+		t.Errorf("Expected a mismatch with the served certificate, got %v", report.Served.Issues)
+	}
+
+	if !report.HasIssues() {
+		t.Error("Report should have issues")
+	}
+}
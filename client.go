@@ -7,6 +7,7 @@ package gofish
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 
@@ -44,52 +45,104 @@ func APIClient(endpoint string, httpClient *http.Client) (c *ApiClient, err erro
 
 // Get performs a GET request against the Redfish service.
 func (c *ApiClient) Get(relativePath string) (*http.Response, error) {
-	return c.do(relativePath, http.MethodGet, nil, http.StatusOK)
+	return c.do(relativePath, http.MethodGet, nil, "", -1, http.StatusOK)
+}
+
+// GetAccepted performs a GET request against the Redfish service that, unlike
+// Get, also accepts the 202 Accepted, 201 Created and 204 No Content
+// responses of a task monitor.
+func (c *ApiClient) GetAccepted(relativePath string) (*http.Response, error) {
+	return c.do(relativePath, http.MethodGet, nil, "", -1, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent)
+}
+
+// GetRaw performs a GET request for data that is not JSON, such as a
+// diagnostic dump, accepting any content type. Redirects are followed by the
+// HTTP client.
+func (c *ApiClient) GetRaw(relativePath string) (*http.Response, error) {
+	return c.doRequest(relativePath, http.MethodGet, nil, "", -1, "", "*/*", http.StatusOK, http.StatusNonAuthoritativeInfo)
 }
 
 // Post performs a Post request against the Redfish service.
 func (c *ApiClient) Post(relativePath string, payload []byte) (*http.Response, error) {
-	return c.do(relativePath, http.MethodPost, payload, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent)
+	body, size := jsonBody(payload)
+	return c.do(relativePath, http.MethodPost, body, "application/json", size, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent)
+}
+
+// PostStream performs a Post request against the Redfish service, sending
+// the body as it is read from the provided reader instead of buffering it in
+// memory. The size is the length of the body in bytes, or -1 if it is not
+// known, in which case the request is sent with chunked transfer encoding.
+func (c *ApiClient) PostStream(relativePath string, contentType string, body io.Reader, size int64) (*http.Response, error) {
+	return c.do(relativePath, http.MethodPost, body, contentType, size, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent)
 }
 
 // Put makes a PUT call.
 func (c *ApiClient) Put(relativePath string, payload []byte) (*http.Response, error) {
-	return c.do(relativePath, http.MethodPut, payload, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent)
+	body, size := jsonBody(payload)
+	return c.do(relativePath, http.MethodPut, body, "application/json", size, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent)
 }
 
 // Patch makes a PATCH call.
 func (c *ApiClient) Patch(relativePath string, payload []byte) (*http.Response, error) {
-	return c.do(relativePath, http.MethodPatch, payload, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent)
+	body, size := jsonBody(payload)
+	return c.do(relativePath, http.MethodPatch, body, "application/json", size, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent)
+}
+
+// PatchIfMatch makes a PATCH call that only succeeds if the resource still
+// has the given ETag. The service rejects the request with status 412 if
+// the resource has changed. An empty etag sends an unconditional PATCH.
+func (c *ApiClient) PatchIfMatch(relativePath string, payload []byte, etag string) (*http.Response, error) {
+	body, size := jsonBody(payload)
+	return c.doIfMatch(relativePath, http.MethodPatch, body, "application/json", size, etag, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent)
 }
 
 // Delete performs a Delete request against the Redfish service.
 func (c *ApiClient) Delete(relativePath string) (*http.Response, error) {
-	return c.do(relativePath, http.MethodDelete, nil, http.StatusOK, http.StatusAccepted, http.StatusNoContent)
+	return c.do(relativePath, http.MethodDelete, nil, "", -1, http.StatusOK, http.StatusAccepted, http.StatusNoContent)
+}
+
+// jsonBody wraps a JSON payload for sending, returning a nil reader if there
+// is no payload.
+func jsonBody(payload []byte) (io.Reader, int64) {
+	if payload == nil {
+		return nil, -1
+	}
+	return bytes.NewReader(payload), int64(len(payload))
+}
+
+func (c *ApiClient) do(relativePath, method string, body io.Reader, contentType string, size int64, statuses ...int) (*http.Response, error) {
+	return c.doIfMatch(relativePath, method, body, contentType, size, "", statuses...)
 }
 
-func (c *ApiClient) do(relativePath, method string, payload []byte, statuses ...int) (*http.Response, error) {
+func (c *ApiClient) doIfMatch(relativePath, method string, body io.Reader, contentType string, size int64, etag string, statuses ...int) (*http.Response, error) {
+	return c.doRequest(relativePath, method, body, contentType, size, etag, "application/json", statuses...)
+}
+
+func (c *ApiClient) doRequest(relativePath, method string, body io.Reader, contentType string, size int64, etag, accept string, statuses ...int) (*http.Response, error) {
 	if relativePath == "" {
 		relativePath = common.DefaultServiceRoot
 	}
 
-	var req *http.Request
-	var err error
 	endpoint := fmt.Sprintf("%s%s", c.Endpoint, relativePath)
-	if payload == nil {
-		req, err = http.NewRequest(method, endpoint, nil)
-	} else {
-		req, err = http.NewRequest(method, endpoint, bytes.NewBuffer(payload))
-		req.Header.Set("Content-Type", "application/json")
-	}
+	req, err := http.NewRequest(method, endpoint, body)
 	if err != nil {
 		return nil, err
 	}
 
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+		if size >= 0 {
+			req.ContentLength = size
+		}
+	}
 	req.Header.Set("User-Agent", "gofish/1.0.0")
-	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept", accept)
 	if c.Token != "" {
 		req.Header.Set("X-Auth-Token", c.Token)
 	}
+	if etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
 	req.Close = true
 
 	resp, err := c.httpClient.Do(req)
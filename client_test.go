@@ -0,0 +1,53 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package gofish
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestAPIClientGetRaw tests downloading data that is not JSON, through a
+// redirect.
+func TestAPIClientGetRaw(t *testing.T) {
+	var accept string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/dump", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/files/dump.bin", http.StatusFound)
+	})
+	mux.HandleFunc("/files/dump.bin", func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept")
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte{0x7f, 'E', 'L', 'F'})
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	client, err := APIClient(ts.URL, nil)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	resp, err := client.GetRaw("/dump")
+	if err != nil {
+		t.Fatalf("Error getting raw data: %s", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Errorf("Error reading raw data: %s", err)
+	}
+
+	if string(data) != "\x7fELF" {
+		t.Errorf("Unexpected raw data: %q", data)
+	}
+
+	if accept != "*/*" {
+		t.Errorf("Unexpected Accept header: %s", accept)
+	}
+}
@@ -0,0 +1,72 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+// Command gofish-compliance checks the firmware of a Redfish service against a
+// baseline manifest. It prints the compliance report as JSON and exits with
+// status 3 if the service is not compliant.
+//
+// Usage:
+//
+//	gofish-compliance [-endpoint http://localhost:5000] <baseline.json|baseline.yaml>
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/rocksolidlabs/gofish"
+)
+
+func main() {
+	endpoint := flag.String("endpoint", "http://localhost:5000", "URL of the Redfish service")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <baseline.json|baseline.yaml>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		fail(err)
+	}
+	baseline, err := gofish.ReadFirmwareBaseline(f)
+	f.Close()
+	if err != nil {
+		fail(err)
+	}
+
+	c, err := gofish.APIClient(*endpoint, nil)
+	if err != nil {
+		fail(err)
+	}
+	service, err := gofish.ServiceRoot(c)
+	if err != nil {
+		fail(err)
+	}
+
+	report, err := service.FirmwareCompliance(baseline)
+	if err != nil {
+		fail(err)
+	}
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		fail(err)
+	}
+	fmt.Println(string(out))
+	if !report.Compliant() {
+		os.Exit(3)
+	}
+}
+
+// fail prints an error and exits.
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -12,6 +12,9 @@ import (
 type Collection struct {
 	Name      string `json:"Name"`
 	ItemLinks []string
+	// NextLink is the location of the next page of the members, if the
+	// service returned only some of them.
+	NextLink string `json:"Members@odata.nextLink"`
 }
 
 // UnmarshalJSON unmarshals a collection from the raw JSON.
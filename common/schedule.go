@@ -4,6 +4,14 @@
 
 package common
 
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
 // DayOfWeek is Days of the Week.
 type DayOfWeek string
 
@@ -64,26 +72,251 @@ type Schedule struct {
 	// enabled, for enabled days of week and months of year. If the array
 	// contains a single value of zero, or if the property is not present,
 	// all days of the month shall be enabled.
-	EnabledDaysOfMonth []int
+	EnabledDaysOfMonth []int `json:",omitempty"`
 	// EnabledDaysOfWeek is Days of the week when scheduled occurrences are
 	// enabled. If not present, all days of the week shall be enabled.
-	EnabledDaysOfWeek []DayOfWeek
+	EnabledDaysOfWeek []DayOfWeek `json:",omitempty"`
 	// EnabledIntervals shall be an ISO 8601 conformant interval specifying when
 	// occurrences are enabled.
-	EnabledIntervals []string
+	EnabledIntervals []string `json:",omitempty"`
 	// EnabledMonthsOfYear is Months of year when scheduled occurrences are
 	// enabled, for enabled days of week and days of month. If not present,
 	// all months of the year shall be enabled.
-	EnabledMonthsOfYear []MonthOfYear
+	EnabledMonthsOfYear []MonthOfYear `json:",omitempty"`
 	// InitialStartTime shall be a date and time of day on which the initial
 	// occurrence is scheduled to occur.
-	InitialStartTime string
+	InitialStartTime string `json:",omitempty"`
 	// Lifetime shall be a Redfish Duration describing the time after
 	// provisioning when the schedule expires.
-	Lifetime string
+	Lifetime string `json:",omitempty"`
 	// MaxOccurrences is Maximum number of scheduled occurrences.
-	MaxOccurrences int
+	MaxOccurrences int `json:",omitempty"`
 	// RecurrenceInterval shall be a Redfish Duration describing the time until
 	// the next occurrence.
-	RecurrenceInterval string
+	RecurrenceInterval string `json:",omitempty"`
+}
+
+// maxScheduleIterations bounds the number of candidate occurrences examined
+// when the enabled days and months rarely or never match.
+const maxScheduleIterations = 100000
+
+// NextRunTimes computes up to n occurrences of the schedule that are after
+// the given time. Occurrences start at InitialStartTime, or at the given time
+// if it is not set, and repeat every RecurrenceInterval. Occurrences outside
+// the enabled days of the week, days of the month, months of the year and
+// intervals are skipped and do not count towards MaxOccurrences.
+func (schedule *Schedule) NextRunTimes(after time.Time, n int) ([]time.Time, error) {
+	var result []time.Time
+
+	start := after
+	if schedule.InitialStartTime != "" {
+		var err error
+		start, err = time.Parse(time.RFC3339, schedule.InitialStartTime)
+		if err != nil {
+			return result, fmt.Errorf("invalid InitialStartTime: %s", err)
+		}
+	}
+
+	var interval isoDuration
+	if schedule.RecurrenceInterval != "" {
+		var err error
+		interval, err = parseISODuration(schedule.RecurrenceInterval)
+		if err != nil {
+			return result, fmt.Errorf("invalid RecurrenceInterval: %s", err)
+		}
+		if !start.Before(interval.addTo(start, 1)) {
+			return result, fmt.Errorf("invalid RecurrenceInterval: %s is not positive", schedule.RecurrenceInterval)
+		}
+	}
+
+	expires := time.Time{}
+	if schedule.Lifetime != "" {
+		lifetime, err := parseISODuration(schedule.Lifetime)
+		if err != nil {
+			return result, fmt.Errorf("invalid Lifetime: %s", err)
+		}
+		expires = lifetime.addTo(start, 1)
+	}
+
+	intervals := make([][2]time.Time, 0, len(schedule.EnabledIntervals))
+	for _, s := range schedule.EnabledIntervals {
+		enabled, err := parseISOInterval(s)
+		if err != nil {
+			return result, fmt.Errorf("invalid EnabledIntervals: %s", err)
+		}
+		intervals = append(intervals, enabled)
+	}
+
+	// Skip ahead to the given time when earlier occurrences do not need to
+	// be counted and the interval has a fixed length.
+	first := 0
+	step := time.Duration(interval.days)*24*time.Hour + interval.clock
+	if schedule.MaxOccurrences == 0 && interval.years == 0 && interval.months == 0 && step > 0 && start.Before(after) {
+		first = int(after.Sub(start) / step)
+		if first > 0 {
+			first--
+		}
+	}
+
+	occurrences := 0
+	for i := first; i-first < maxScheduleIterations && len(result) < n; i++ {
+		if schedule.MaxOccurrences > 0 && occurrences >= schedule.MaxOccurrences {
+			break
+		}
+
+		candidate := interval.addTo(start, i)
+		if !expires.IsZero() && candidate.After(expires) {
+			break
+		}
+
+		if schedule.enabled(candidate, intervals) {
+			occurrences++
+			if candidate.After(after) {
+				result = append(result, candidate)
+			}
+		}
+
+		if schedule.RecurrenceInterval == "" {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// enabled checks whether an occurrence at the given time is allowed by the
+// enabled days, months and intervals of the schedule.
+func (schedule *Schedule) enabled(t time.Time, intervals [][2]time.Time) bool {
+	if len(schedule.EnabledDaysOfWeek) > 0 {
+		found := false
+		for _, day := range schedule.EnabledDaysOfWeek {
+			if day == EveryDayOfWeek || string(day) == t.Weekday().String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(schedule.EnabledDaysOfMonth) > 0 {
+		found := false
+		for _, day := range schedule.EnabledDaysOfMonth {
+			if day == 0 || day == t.Day() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(schedule.EnabledMonthsOfYear) > 0 {
+		found := false
+		for _, month := range schedule.EnabledMonthsOfYear {
+			if month == EveryMonthOfYear || string(month) == t.Month().String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(intervals) > 0 {
+		found := false
+		for _, interval := range intervals {
+			if !t.Before(interval[0]) && t.Before(interval[1]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isoDuration is an ISO 8601 duration, keeping the calendar parts separate
+// since months and years vary in length.
+type isoDuration struct {
+	years, months, days int
+	clock               time.Duration
+}
+
+// addTo adds the duration to t the given number of times. Years and months
+// keep the day of the month, clamped to the last day of shorter months, so
+// that a monthly schedule starting on the 31st runs on the last day of each
+// month.
+func (d isoDuration) addTo(t time.Time, times int) time.Time {
+	if months := (d.years*12 + d.months) * times; months != 0 {
+		year, month, day := t.Date()
+		first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		if last := first.AddDate(0, 1, -1).Day(); day > last {
+			day = last
+		}
+		t = first.AddDate(0, 0, day-1)
+	}
+
+	return t.AddDate(0, 0, d.days*times).Add(d.clock * time.Duration(times))
+}
+
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseISODuration parses an ISO 8601 duration such as "P1DT2H" or
+// "PT0.5S", the format of Redfish Duration properties.
+func parseISODuration(s string) (isoDuration, error) {
+	var d isoDuration
+	m := isoDurationPattern.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return d, fmt.Errorf("%q is not an ISO 8601 duration", s)
+	}
+
+	atoi := func(s string) int {
+		v, _ := strconv.Atoi(s)
+		return v
+	}
+	d.years = atoi(m[1])
+	d.months = atoi(m[2])
+	d.days = atoi(m[3])*7 + atoi(m[4])
+	d.clock = time.Duration(atoi(m[5]))*time.Hour + time.Duration(atoi(m[6]))*time.Minute
+	if m[7] != "" {
+		seconds, _ := strconv.ParseFloat(m[7], 64)
+		d.clock += time.Duration(seconds * float64(time.Second))
+	}
+
+	return d, nil
+}
+
+// parseISOInterval parses an ISO 8601 interval given as a start and end time,
+// or a start time and a duration.
+func parseISOInterval(s string) ([2]time.Time, error) {
+	var interval [2]time.Time
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 {
+		return interval, fmt.Errorf("%q is not an ISO 8601 interval", s)
+	}
+
+	start, err := time.Parse(time.RFC3339, parts[0])
+	if err != nil {
+		return interval, err
+	}
+	interval[0] = start
+
+	if strings.HasPrefix(parts[1], "P") {
+		d, err := parseISODuration(parts[1])
+		if err != nil {
+			return interval, err
+		}
+		interval[1] = d.addTo(start, 1)
+		return interval, nil
+	}
+
+	interval[1], err = time.Parse(time.RFC3339, parts[1])
+	return interval, err
 }
@@ -0,0 +1,175 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+// TestScheduleNextRunTimes tests computing the occurrences of a schedule.
+func TestScheduleNextRunTimes(t *testing.T) {
+	after := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		n        int
+		expected []string
+	}{
+		{
+			name: "daily",
+			schedule: Schedule{
+				InitialStartTime:   "2020-05-01T02:00:00Z",
+				RecurrenceInterval: "P1D",
+			},
+			n:        3,
+			expected: []string{"2020-06-02T02:00:00Z", "2020-06-03T02:00:00Z", "2020-06-04T02:00:00Z"},
+		},
+		{
+			name: "weekends only",
+			schedule: Schedule{
+				InitialStartTime:   "2020-06-01T02:00:00Z",
+				RecurrenceInterval: "P1D",
+				EnabledDaysOfWeek:  []DayOfWeek{SaturdayDayOfWeek, SundayDayOfWeek},
+			},
+			n:        3,
+			expected: []string{"2020-06-06T02:00:00Z", "2020-06-07T02:00:00Z", "2020-06-13T02:00:00Z"},
+		},
+		{
+			name: "first of the month in the first quarter",
+			schedule: Schedule{
+				InitialStartTime:    "2020-06-01T02:00:00Z",
+				RecurrenceInterval:  "P1D",
+				EnabledDaysOfMonth:  []int{1},
+				EnabledMonthsOfYear: []MonthOfYear{JanuaryMonthOfYear, FebruaryMonthOfYear, MarchMonthOfYear},
+			},
+			n:        4,
+			expected: []string{"2021-01-01T02:00:00Z", "2021-02-01T02:00:00Z", "2021-03-01T02:00:00Z", "2022-01-01T02:00:00Z"},
+		},
+		{
+			name: "limited occurrences",
+			schedule: Schedule{
+				InitialStartTime:   "2020-06-01T00:00:00Z",
+				RecurrenceInterval: "PT6H",
+				MaxOccurrences:     4,
+			},
+			n:        10,
+			expected: []string{"2020-06-01T18:00:00Z"},
+		},
+		{
+			name: "lifetime",
+			schedule: Schedule{
+				InitialStartTime:   "2020-06-01T13:00:00Z",
+				RecurrenceInterval: "PT1H",
+				Lifetime:           "PT2H",
+			},
+			n:        10,
+			expected: []string{"2020-06-01T13:00:00Z", "2020-06-01T14:00:00Z", "2020-06-01T15:00:00Z"},
+		},
+		{
+			name: "enabled interval",
+			schedule: Schedule{
+				InitialStartTime:   "2020-06-01T00:00:00Z",
+				RecurrenceInterval: "PT30M",
+				EnabledIntervals:   []string{"2020-06-01T22:00:00Z/PT1H"},
+			},
+			n:        10,
+			expected: []string{"2020-06-01T22:00:00Z", "2020-06-01T22:30:00Z"},
+		},
+		{
+			name: "single occurrence",
+			schedule: Schedule{
+				InitialStartTime: "2020-06-02T00:00:00Z",
+			},
+			n:        3,
+			expected: []string{"2020-06-02T00:00:00Z"},
+		},
+		{
+			name: "monthly",
+			schedule: Schedule{
+				InitialStartTime:   "2020-01-31T00:00:00Z",
+				RecurrenceInterval: "P1M",
+			},
+			n:        2,
+			expected: []string{"2020-06-30T00:00:00Z", "2020-07-31T00:00:00Z"},
+		},
+	}
+
+	for _, test := range tests {
+		result, err := test.schedule.NextRunTimes(after, test.n)
+		if err != nil {
+			t.Errorf("%s: error computing run times: %s", test.name, err)
+			continue
+		}
+
+		if len(result) != len(test.expected) {
+			t.Errorf("%s: expected %d run times, got %v", test.name, len(test.expected), result)
+			continue
+		}
+
+		for i, expected := range test.expected {
+			if result[i].Format(time.RFC3339) != expected {
+				t.Errorf("%s: expected run time %d to be %s, got %s", test.name, i, expected, result[i].Format(time.RFC3339))
+			}
+		}
+	}
+}
+
+// TestScheduleNextRunTimesInvalid tests invalid schedules are rejected.
+func TestScheduleNextRunTimesInvalid(t *testing.T) {
+	schedules := []Schedule{
+		{InitialStartTime: "tomorrow"},
+		{RecurrenceInterval: "1 day"},
+		{RecurrenceInterval: "PT0S"},
+		{RecurrenceInterval: "P1D", EnabledIntervals: []string{"2020-06-01T00:00:00Z"}},
+	}
+
+	for _, schedule := range schedules {
+		_, err := schedule.NextRunTimes(time.Now(), 1)
+		if err == nil {
+			t.Errorf("Expected an error for %+v", schedule)
+		}
+	}
+}
+
+// TestParseISODuration tests parsing Redfish durations.
+func TestParseISODuration(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]string{
+		"P1Y2M3DT4H5M6S": "2021-03-04T04:05:06Z",
+		"P2W":            "2020-01-15T00:00:00Z",
+		"PT0.5S":         "2020-01-01T00:00:00.5Z",
+	}
+
+	// Months are clamped to the last day of the month.
+	end := time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)
+	d, err := parseISODuration("P1M")
+	if err != nil {
+		t.Fatalf("Error parsing P1M: %s", err)
+	}
+	for times, expected := range []string{"2020-01-31T00:00:00Z", "2020-02-29T00:00:00Z", "2020-03-31T00:00:00Z", "2020-04-30T00:00:00Z"} {
+		if result := d.addTo(end, times).Format(time.RFC3339); result != expected {
+			t.Errorf("Expected %d months after %s to be %s, got %s", times, end.Format(time.RFC3339), expected, result)
+		}
+	}
+
+	for s, expected := range tests {
+		d, err := parseISODuration(s)
+		if err != nil {
+			t.Errorf("Error parsing %s: %s", s, err)
+			continue
+		}
+		if result := d.addTo(start, 1).Format(time.RFC3339Nano); result != expected {
+			t.Errorf("Expected %s to end at %s, got %s", s, expected, result)
+		}
+	}
+
+	for _, s := range []string{"", "P", "PT", "1D", "P1H"} {
+		if _, err := parseISODuration(s); err == nil {
+			t.Errorf("Expected an error parsing %q", s)
+		}
+	}
+}
@@ -6,7 +6,10 @@ package common
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
+	"reflect"
+	"strings"
 )
 
 // DefaultServiceRoot is the default path to the Redfish service endpoint.
@@ -15,8 +18,12 @@ const DefaultServiceRoot = "/redfish/v1/"
 // Client is a connection to a Redfish service.
 type Client interface {
 	Get(url string) (*http.Response, error)
+	GetAccepted(url string) (*http.Response, error)
+	GetRaw(url string) (*http.Response, error)
 	Post(url string, payload []byte) (*http.Response, error)
+	PostStream(url string, contentType string, body io.Reader, size int64) (*http.Response, error)
 	Patch(url string, payload []byte) (*http.Response, error)
+	PatchIfMatch(url string, payload []byte, etag string) (*http.Response, error)
 	Put(url string, payload []byte) (*http.Response, error)
 	Delete(url string) (*http.Response, error)
 }
@@ -27,7 +34,7 @@ type Entity struct {
 	ID string `json:"Id"`
 	// Name is the name of the resource or array element.
 	Name   string `json:"Name"`
-	Client Client
+	Client Client `json:"-"`
 }
 
 // SetClient sets the API client connection to use for accessing this
@@ -36,6 +43,107 @@ func (e *Entity) SetClient(c Client) {
 	e.Client = c
 }
 
+// Post sends the payload, encoded as JSON, to the given URI using the
+// client of this entity. This is typically used to invoke actions.
+func (e *Entity) Post(uri string, payload interface{}) (*http.Response, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Client.Post(uri, body)
+}
+
+// Patch sends the payload, encoded as JSON, to the given URI using the
+// client of this entity.
+func (e *Entity) Patch(uri string, payload interface{}) (*http.Response, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Client.Patch(uri, body)
+}
+
+// PatchIfMatch sends the payload, encoded as JSON, to the given URI using
+// the client of this entity, only if the resource still has the given ETag.
+func (e *Entity) PatchIfMatch(uri string, payload interface{}, etag string) (*http.Response, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Client.PatchIfMatch(uri, body, etag)
+}
+
+// Update sends the properties of currentEntity that differ from
+// originalEntity to the given URI with a PATCH. Only the fields named in
+// allowedUpdates are considered. Both values must be the same struct type,
+// typically the element of a resource pointer and a copy of it decoded from
+// rawData, the JSON the resource was read from. No request is made if
+// nothing has changed. After a successful PATCH the sent values are stored
+// in rawData, so later updates are compared against them.
+func (e *Entity) Update(uri string, originalEntity, currentEntity reflect.Value, allowedUpdates []string, rawData *[]byte) error {
+	payload := make(map[string]interface{})
+	for _, fieldName := range allowedUpdates {
+		field, ok := originalEntity.Type().FieldByName(fieldName)
+		if !ok {
+			continue
+		}
+
+		originalValue := originalEntity.FieldByIndex(field.Index).Interface()
+		currentValue := currentEntity.FieldByIndex(field.Index).Interface()
+		if reflect.DeepEqual(originalValue, currentValue) {
+			continue
+		}
+
+		name := field.Name
+		if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag != "" {
+			name = tag
+		}
+		payload[name] = currentValue
+	}
+
+	if len(payload) == 0 {
+		return nil
+	}
+
+	resp, err := e.Patch(uri, payload)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	updated, err := mergeJSON(*rawData, payload)
+	if err != nil {
+		return err
+	}
+	*rawData = updated
+
+	return nil
+}
+
+// mergeJSON sets the given properties in the JSON object.
+func mergeJSON(b []byte, properties map[string]interface{}) ([]byte, error) {
+	fields := make(map[string]json.RawMessage)
+	if len(b) > 0 {
+		err := json.Unmarshal(b, &fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for name, value := range properties {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = encoded
+	}
+
+	return json.Marshal(fields)
+}
+
 // Link is an OData link reference
 type Link string
 
@@ -54,6 +162,13 @@ func (l *Link) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON marshals a Link as an OData reference.
+func (l Link) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ODataID string `json:"@odata.id"`
+	}{string(l)})
+}
+
 // Links are a collection of Link references
 type Links []Link
 
@@ -498,3 +613,126 @@ type Operations struct {
 	// operation that has been completed.
 	PercentageComplete int
 }
+
+// OperationApplyTime is the time when an operation is applied.
+type OperationApplyTime string
+
+const (
+	// ImmediateOperationApplyTime shall be used to indicate the requested
+	// operation is applied immediately.
+	ImmediateOperationApplyTime OperationApplyTime = "Immediate"
+	// OnResetOperationApplyTime shall be used to indicate the requested
+	// operation is applied on a reset.
+	OnResetOperationApplyTime OperationApplyTime = "OnReset"
+	// AtMaintenanceWindowStartOperationApplyTime shall be used to indicate
+	// the requested operation is applied during a maintenance window as
+	// specified by an administrator.
+	AtMaintenanceWindowStartOperationApplyTime OperationApplyTime = "AtMaintenanceWindowStart"
+	// InMaintenanceWindowOnResetOperationApplyTime shall be used to indicate
+	// the requested operation is applied after a reset but within the
+	// maintenance window as specified by an adminstrator.
+	InMaintenanceWindowOnResetOperationApplyTime OperationApplyTime = "InMaintenanceWindowOnReset"
+	// OnStartUpdateRequestOperationApplyTime shall be used to indicate the
+	// requested operation is applied when the StartUpdate action of the
+	// update service is invoked.
+	OnStartUpdateRequestOperationApplyTime OperationApplyTime = "OnStartUpdateRequest"
+)
+
+// CollectionCapabilityUseCase is the use case of a collection capability.
+type CollectionCapabilityUseCase string
+
+const (
+	// ComputerSystemCompositionCollectionCapabilityUseCase shall indicate the
+	// capability describes a POST to compose a ComputerSystem from resource
+	// blocks without constraints.
+	ComputerSystemCompositionCollectionCapabilityUseCase CollectionCapabilityUseCase = "ComputerSystemComposition"
+	// ComputerSystemConstrainedCompositionCollectionCapabilityUseCase shall
+	// indicate the capability describes a POST to compose a ComputerSystem
+	// from a set of constraints.
+	ComputerSystemConstrainedCompositionCollectionCapabilityUseCase CollectionCapabilityUseCase = "ComputerSystemConstrainedComposition"
+	// VolumeCreationCollectionCapabilityUseCase shall indicate the capability
+	// describes a POST to create a Volume.
+	VolumeCreationCollectionCapabilityUseCase CollectionCapabilityUseCase = "VolumeCreation"
+)
+
+// CollectionCapability shall describe one way a client can POST to a
+// collection.
+type CollectionCapability struct {
+	// CapabilitiesObject shall contain the link to the resource describing
+	// the allowable values of the POST request body.
+	CapabilitiesObject string
+	// UseCase shall contain the use case for this capability.
+	UseCase CollectionCapabilityUseCase
+	// TargetCollection shall contain the link to the collection this
+	// capability applies to.
+	TargetCollection string
+	// RelatedItem shall contain links to resources related to this
+	// capability.
+	RelatedItem []string
+}
+
+// UnmarshalJSON unmarshals a CollectionCapability object from the raw JSON.
+func (capability *CollectionCapability) UnmarshalJSON(b []byte) error {
+	type temp CollectionCapability
+	var t struct {
+		temp
+		CapabilitiesObject Link
+		Links              struct {
+			TargetCollection Link
+			RelatedItem      Links
+		}
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*capability = CollectionCapability(t.temp)
+	capability.CapabilitiesObject = string(t.CapabilitiesObject)
+	capability.TargetCollection = string(t.Links.TargetCollection)
+	capability.RelatedItem = t.Links.RelatedItem.ToStrings()
+
+	return nil
+}
+
+// CollectionCapabilities shall describe the POST capabilities of the
+// collections related to a resource.
+type CollectionCapabilities struct {
+	// Capabilities shall contain the capabilities of the collections.
+	Capabilities []CollectionCapability
+	// MaxMembers shall contain the maximum number of members allowed in the
+	// collection.
+	MaxMembers int
+}
+
+// Float32 gets a pointer to the value, for setting optional readings and
+// thresholds.
+func Float32(value float32) *float32 {
+	return &value
+}
+
+// Int gets a pointer to the value, for setting optional properties where zero
+// is a meaningful value.
+func Int(value int) *int {
+	return &value
+}
+
+// Float32Value gets the value of an optional reading or threshold, and
+// whether the service reported it. A reading that was absent or null is not
+// reported, which is different from a reading of zero.
+func Float32Value(value *float32) (float32, bool) {
+	if value == nil {
+		return 0, false
+	}
+	return *value, true
+}
+
+// Float32Or gets the value of an optional reading or threshold, or the
+// fallback if the service did not report it.
+func Float32Or(value *float32, fallback float32) float32 {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
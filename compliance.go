@@ -0,0 +1,429 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package gofish
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rocksolidlabs/gofish/redfish"
+	"gopkg.in/yaml.v3"
+)
+
+// ComplianceStatus is the result of checking a component against a baseline.
+type ComplianceStatus string
+
+const (
+	// UpToDateComplianceStatus indicates the installed version satisfies the
+	// baseline.
+	UpToDateComplianceStatus ComplianceStatus = "UpToDate"
+	// OutdatedComplianceStatus indicates the installed version does not
+	// satisfy the baseline.
+	OutdatedComplianceStatus ComplianceStatus = "Outdated"
+	// UnknownComplianceStatus indicates the installed version could not be
+	// compared with the baseline.
+	UnknownComplianceStatus ComplianceStatus = "Unknown"
+	// MissingComplianceStatus indicates no installed component matched the
+	// baseline entry.
+	MissingComplianceStatus ComplianceStatus = "Missing"
+)
+
+// FirmwareBaseline describes the software versions a service is required to
+// be running.
+type FirmwareBaseline struct {
+	// Name identifies the baseline in reports.
+	Name string `json:"Name" yaml:"Name"`
+	// Components are the requirements of the baseline.
+	Components []BaselineComponent `json:"Components" yaml:"Components"`
+}
+
+// BaselineComponent is a version requirement for the software inventory
+// items it matches.
+type BaselineComponent struct {
+	// SoftwareID is a shell pattern matched against the SoftwareId of the
+	// inventory items.
+	SoftwareID string `json:"SoftwareId,omitempty" yaml:"SoftwareId,omitempty"`
+	// Name is a shell pattern matched against the Name of the inventory
+	// items. If both SoftwareID and Name are given, both must match.
+	Name string `json:"Name,omitempty" yaml:"Name,omitempty"`
+	// Version is the required version. It is a comma separated list of
+	// constraints, each an operator (=, !=, <, <=, >, >=) and a version. A
+	// version without an operator is a minimum version.
+	Version string `json:"Version" yaml:"Version"`
+}
+
+// ReadFirmwareBaseline reads a JSON or YAML baseline manifest. A manifest
+// that starts with "{" is read as JSON, anything else as YAML.
+func ReadFirmwareBaseline(r io.Reader) (*FirmwareBaseline, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseline FirmwareBaseline
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		err = json.Unmarshal(data, &baseline)
+	} else {
+		err = yaml.Unmarshal(data, &baseline)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, component := range baseline.Components {
+		if component.SoftwareID == "" && component.Name == "" {
+			return nil, fmt.Errorf("baseline component %d has no SoftwareId or Name", i)
+		}
+		if _, err := parseVersionConstraints(component.Version); err != nil {
+			return nil, fmt.Errorf("baseline component %d: %s", i, err)
+		}
+	}
+
+	return &baseline, nil
+}
+
+// matches indicates whether the inventory item is covered by this
+// requirement.
+func (component *BaselineComponent) matches(item *redfish.SoftwareInventory) bool {
+	if component.SoftwareID != "" {
+		if ok, _ := path.Match(component.SoftwareID, item.SoftwareID); !ok {
+			return false
+		}
+	}
+	if component.Name != "" {
+		if ok, _ := path.Match(component.Name, item.Name); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ComponentCompliance is the result of checking one inventory item against a
+// baseline requirement.
+type ComponentCompliance struct {
+	// Requirement is the baseline entry that was checked.
+	Requirement BaselineComponent
+	// ODataID is the location of the inventory item, empty if the
+	// requirement was missing.
+	ODataID string `json:"@odata.id,omitempty"`
+	// ID is the Id of the inventory item.
+	ID string `json:"Id,omitempty"`
+	// Name is the name of the inventory item.
+	Name string `json:",omitempty"`
+	// SoftwareID is the SoftwareId of the inventory item.
+	SoftwareID string `json:"SoftwareId,omitempty"`
+	// Version is the installed version.
+	Version string `json:",omitempty"`
+	// Status is the result of the check.
+	Status ComplianceStatus
+	// Reason explains a status other than up to date.
+	Reason string `json:",omitempty"`
+}
+
+// ComplianceReport is the result of checking an inventory against a
+// baseline.
+type ComplianceReport struct {
+	// Baseline is the name of the baseline that was checked.
+	Baseline string
+	// Components has an entry for every inventory item matched by the
+	// baseline, and for every baseline entry that matched nothing.
+	Components []ComponentCompliance
+}
+
+// Compliant indicates whether every component in the report is up to date.
+func (report *ComplianceReport) Compliant() bool {
+	for _, component := range report.Components {
+		if component.Status != UpToDateComplianceStatus {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckFirmwareCompliance evaluates the software inventory against the
+// baseline.
+func CheckFirmwareCompliance(baseline *FirmwareBaseline, inventory []*redfish.SoftwareInventory) *ComplianceReport {
+	report := &ComplianceReport{Baseline: baseline.Name}
+
+	for _, requirement := range baseline.Components {
+		matched := false
+		for _, item := range inventory {
+			if !requirement.matches(item) {
+				continue
+			}
+			matched = true
+
+			result := ComponentCompliance{
+				Requirement: requirement,
+				ODataID:     item.ODataID,
+				ID:          item.ID,
+				Name:        item.Name,
+				SoftwareID:  item.SoftwareID,
+				Version:     item.Version,
+			}
+
+			ok, err := satisfiesVersion(item.Version, requirement.Version)
+			switch {
+			case err != nil:
+				result.Status = UnknownComplianceStatus
+				result.Reason = err.Error()
+			case ok:
+				result.Status = UpToDateComplianceStatus
+			default:
+				result.Status = OutdatedComplianceStatus
+				result.Reason = fmt.Sprintf("version %s does not satisfy %s", item.Version, requirement.Version)
+			}
+			report.Components = append(report.Components, result)
+		}
+
+		if !matched {
+			report.Components = append(report.Components, ComponentCompliance{
+				Requirement: requirement,
+				Status:      MissingComplianceStatus,
+				Reason:      "no matching component in the inventory",
+			})
+		}
+	}
+
+	return report
+}
+
+// FirmwareCompliance checks the firmware and software inventory of the
+// service against the baseline.
+func (serviceroot *Service) FirmwareCompliance(baseline *FirmwareBaseline) (*ComplianceReport, error) {
+	updateService, err := serviceroot.UpdateService()
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := updateService.FirmwareInventories()
+	if err != nil {
+		return nil, err
+	}
+
+	software, err := updateService.SoftwareInventories()
+	if err != nil {
+		return nil, err
+	}
+
+	// Services may point both inventories at the same collection, so items
+	// are only checked once.
+	seen := make(map[string]bool, len(inventory))
+	for _, item := range inventory {
+		seen[item.ODataID] = true
+	}
+	for _, item := range software {
+		if item.ODataID != "" && seen[item.ODataID] {
+			continue
+		}
+		seen[item.ODataID] = true
+		inventory = append(inventory, item)
+	}
+
+	return CheckFirmwareCompliance(baseline, inventory), nil
+}
+
+// versionConstraint is a single comparison against a version.
+type versionConstraint struct {
+	op      string
+	version string
+}
+
+var constraintPattern = regexp.MustCompile(`^(==|=|!=|<=|>=|<|>)?\s*(.+)$`)
+
+func parseVersionConstraints(constraints string) ([]versionConstraint, error) {
+	var result []versionConstraint
+	for _, c := range strings.Split(constraints, ",") {
+		c = strings.TrimSpace(c)
+		match := constraintPattern.FindStringSubmatch(c)
+		if match == nil {
+			return nil, fmt.Errorf("invalid version constraint %q", constraints)
+		}
+
+		op := match[1]
+		if op == "" {
+			op = ">="
+		} else if op == "==" {
+			op = "="
+		}
+		if _, err := parseVersion(match[2]); err != nil {
+			return nil, err
+		}
+		result = append(result, versionConstraint{op: op, version: match[2]})
+	}
+	return result, nil
+}
+
+func satisfiesVersion(version, constraints string) (bool, error) {
+	parsed, err := parseVersionConstraints(constraints)
+	if err != nil {
+		return false, err
+	}
+
+	for _, constraint := range parsed {
+		cmp, err := CompareVersions(version, constraint.version)
+		if err != nil {
+			return false, err
+		}
+
+		var ok bool
+		switch constraint.op {
+		case "=":
+			ok = cmp == 0
+		case "!=":
+			ok = cmp != 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+var (
+	parenthesizedPattern  = regexp.MustCompile(`\([^)]*\)`)
+	dottedPattern         = regexp.MustCompile(`\d+(?:[._]\d+)+`)
+	numberPattern         = regexp.MustCompile(`\d+`)
+	preReleasePattern     = regexp.MustCompile(`^[-~]([A-Za-z][0-9A-Za-z.]*)`)
+	preReleasePartPattern = regexp.MustCompile(`\d+|[^\d.]+`)
+)
+
+// version is the comparable form of a vendor version string.
+type version struct {
+	numbers    []int
+	preRelease string
+}
+
+// parseVersion extracts the comparable part of a version string. Vendors
+// often wrap the version number with other text, such as "U30 v2.42
+// (10/10/2020)" or "iDRAC 4.22.00.201", so the longest dotted number found is
+// used, or failing that the first number.
+func parseVersion(s string) (*version, error) {
+	s = parenthesizedPattern.ReplaceAllString(s, "")
+
+	loc := []int(nil)
+	for _, l := range dottedPattern.FindAllStringIndex(s, -1) {
+		if loc == nil || l[1]-l[0] > loc[1]-loc[0] {
+			loc = l
+		}
+	}
+	if loc == nil {
+		loc = numberPattern.FindStringIndex(s)
+	}
+	if loc == nil {
+		return nil, fmt.Errorf("unrecognized version %q", s)
+	}
+
+	result := &version{}
+	for _, part := range strings.FieldsFunc(s[loc[0]:loc[1]], func(r rune) bool { return r == '.' || r == '_' }) {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("unrecognized version %q", s)
+		}
+		result.numbers = append(result.numbers, n)
+	}
+
+	if match := preReleasePattern.FindStringSubmatch(s[loc[1]:]); match != nil {
+		result.preRelease = strings.ToLower(match[1])
+	}
+
+	return result, nil
+}
+
+// CompareVersions compares two version strings, returning -1, 0 or 1 if a is
+// older than, the same as, or newer than b. Missing trailing components are
+// treated as zero, and a pre-release such as "1.2-rc1" is older than the
+// release it precedes. An error is returned if either version cannot be
+// recognized.
+func CompareVersions(a, b string) (int, error) {
+	va, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < len(va.numbers) || i < len(vb.numbers); i++ {
+		var na, nb int
+		if i < len(va.numbers) {
+			na = va.numbers[i]
+		}
+		if i < len(vb.numbers) {
+			nb = vb.numbers[i]
+		}
+		if na != nb {
+			if na < nb {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+
+	switch {
+	case va.preRelease == vb.preRelease:
+		return 0, nil
+	case va.preRelease == "":
+		return 1, nil
+	case vb.preRelease == "":
+		return -1, nil
+	}
+	return comparePreReleases(va.preRelease, vb.preRelease), nil
+}
+
+// comparePreReleases compares two pre-release labels, such as "rc2" and
+// "rc10". The labels are split into runs of digits and other characters,
+// digit runs are compared numerically and the rest as text.
+func comparePreReleases(a, b string) int {
+	pa := preReleasePartPattern.FindAllString(a, -1)
+	pb := preReleasePartPattern.FindAllString(b, -1)
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		na, errA := strconv.Atoi(pa[i])
+		nb, errB := strconv.Atoi(pb[i])
+		switch {
+		case errA == nil && errB == nil:
+			if na != nb {
+				if na < nb {
+					return -1
+				}
+				return 1
+			}
+		case errA == nil:
+			// Numbers sort before text, as in semantic versioning.
+			return -1
+		case errB == nil:
+			return 1
+		case pa[i] != pb[i]:
+			if pa[i] < pb[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(pa) < len(pb):
+		return -1
+	case len(pa) > len(pb):
+		return 1
+	}
+	return 0
+}
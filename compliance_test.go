@@ -0,0 +1,207 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package gofish
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/redfish"
+)
+
+// TestCompareVersions tests the comparison of vendor version strings.
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2", "1.2.0", 0},
+		{"1.10.0", "1.9.9", 1},
+		{"v2.1", "2.2", -1},
+		{"U30 v2.42 (10/10/2020)", "2.40", 1},
+		{"iDRAC 4.22.00.201", "4.22.00.200", 1},
+		{"1.0.0-rc1", "1.0.0", -1},
+		{"1.0.0-rc2", "1.0.0-rc1", 1},
+		{"1.0.0-rc2", "1.0.0-rc10", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-rc1", "1.0.0-rc1.1", -1},
+		{"1.0.0-beta", "1.0.0-rc1", -1},
+		{"20_5_13", "20.5.13", 0},
+		{"A03", "A02", 1},
+	}
+
+	for _, test := range tests {
+		result, err := CompareVersions(test.a, test.b)
+		if err != nil {
+			t.Errorf("Error comparing %s and %s: %s", test.a, test.b, err)
+		}
+		if result != test.expected {
+			t.Errorf("Comparing %s and %s: expected %d, got %d", test.a, test.b, test.expected, result)
+		}
+	}
+
+	if _, err := CompareVersions("unknown", "1.0"); err == nil {
+		t.Error("Expected an error comparing an unrecognized version")
+	}
+}
+
+var baselineBody = strings.NewReader(
+	`{
+		"Name": "2020-Q3",
+		"Components": [
+			{
+				"SoftwareId": "BMC",
+				"Version": "1.45"
+			},
+			{
+				"Name": "BIOS*",
+				"Version": ">=2.10.2, <3"
+			},
+			{
+				"Name": "NIC*",
+				"Version": "=20.5.13"
+			},
+			{
+				"Name": "CPLD",
+				"Version": "1.0"
+			}
+		]
+	}`)
+
+// TestReadFirmwareBaselineYAML tests reading a YAML baseline manifest.
+func TestReadFirmwareBaselineYAML(t *testing.T) {
+	baseline, err := ReadFirmwareBaseline(strings.NewReader(`
+Name: 2020-Q3
+Components:
+  - SoftwareId: BMC
+    Version: 1.45
+  - Name: "BIOS*"
+    Version: ">=2.10.2, <3"
+`))
+	if err != nil {
+		t.Fatalf("Error reading baseline: %s", err)
+	}
+
+	if baseline.Name != "2020-Q3" || len(baseline.Components) != 2 {
+		t.Fatalf("Unexpected baseline: %v", baseline)
+	}
+
+	if baseline.Components[0].SoftwareID != "BMC" || baseline.Components[0].Version != "1.45" {
+		t.Errorf("Unexpected first component: %v", baseline.Components[0])
+	}
+
+	if baseline.Components[1].Name != "BIOS*" || baseline.Components[1].Version != ">=2.10.2, <3" {
+		t.Errorf("Unexpected second component: %v", baseline.Components[1])
+	}
+
+	_, err = ReadFirmwareBaseline(strings.NewReader("Components:\n  - Version: 1.0\n"))
+	if err == nil {
+		t.Error("Expected an error for a component without SoftwareId or Name")
+	}
+}
+
+// TestFirmwareCompliance tests checking an inventory against a baseline.
+func TestFirmwareCompliance(t *testing.T) {
+	baseline, err := ReadFirmwareBaseline(baselineBody)
+	if err != nil {
+		t.Errorf("Error reading baseline: %s", err)
+	}
+
+	inventory := []*redfish.SoftwareInventory{
+		{SoftwareID: "BMC", Version: "1.45.455b66-rev4"},
+		{SoftwareID: "BIOS", Version: "U30 v2.10.4 (03/01/2020)"},
+		{SoftwareID: "NIC1", Version: "20.5.13"},
+		{SoftwareID: "NIC2", Version: ""},
+	}
+	inventory[0].Name = "BMC Firmware"
+	inventory[1].Name = "BIOS"
+	inventory[2].Name = "NIC Port 1"
+	inventory[3].Name = "NIC Port 2"
+
+	report := CheckFirmwareCompliance(baseline, inventory)
+
+	expected := []ComplianceStatus{
+		UpToDateComplianceStatus,
+		UpToDateComplianceStatus,
+		UpToDateComplianceStatus,
+		UnknownComplianceStatus,
+		MissingComplianceStatus,
+	}
+	if len(report.Components) != len(expected) {
+		t.Errorf("Expected %d results, got %d", len(expected), len(report.Components))
+	}
+	for i, status := range expected {
+		if report.Components[i].Status != status {
+			t.Errorf("Result %d: expected %s, got %s", i, status, report.Components[i].Status)
+		}
+	}
+
+	if report.Compliant() {
+		t.Error("Report should not be compliant")
+	}
+
+	inventory[0].Version = "1.30"
+	report = CheckFirmwareCompliance(baseline, inventory)
+	if report.Components[0].Status != OutdatedComplianceStatus {
+		t.Errorf("Expected outdated BMC, got %s", report.Components[0].Status)
+	}
+}
+
+// TestServiceFirmwareCompliance tests that items listed in both the firmware
+// and software inventory are only reported once.
+func TestServiceFirmwareCompliance(t *testing.T) {
+	inventory := `{
+		"Members": [{"@odata.id": "/redfish/v1/UpdateService/FirmwareInventory/BMC"}],
+		"Members@odata.count": 1
+	}`
+	responses := map[string]string{
+		"/redfish/v1/": `{"UpdateService": {"@odata.id": "/redfish/v1/UpdateService"}}`,
+		"/redfish/v1/UpdateService": `{
+			"@odata.id": "/redfish/v1/UpdateService",
+			"FirmwareInventory": {"@odata.id": "/redfish/v1/UpdateService/FirmwareInventory"},
+			"SoftwareInventory": {"@odata.id": "/redfish/v1/UpdateService/FirmwareInventory"}
+		}`,
+		"/redfish/v1/UpdateService/FirmwareInventory": inventory,
+		"/redfish/v1/UpdateService/FirmwareInventory/BMC": `{
+			"@odata.id": "/redfish/v1/UpdateService/FirmwareInventory/BMC",
+			"SoftwareId": "BMC",
+			"Version": "1.45"
+		}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	client, err := APIClient(server.URL, nil)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	service, err := ServiceRoot(client)
+	if err != nil {
+		t.Fatalf("Error getting service root: %s", err)
+	}
+
+	report, err := service.FirmwareCompliance(&FirmwareBaseline{
+		Components: []BaselineComponent{{SoftwareID: "BMC", Version: "1.45"}},
+	})
+	if err != nil {
+		t.Fatalf("Error checking compliance: %s", err)
+	}
+
+	if len(report.Components) != 1 || report.Components[0].Status != UpToDateComplianceStatus {
+		t.Errorf("Unexpected compliance report: %v", report.Components)
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"os"
 	s "strings"
 
-	gofish "github.com/rocksolidlabs/gofish/school"
+	gofish "github.com/rocksolidlabs/gofish"
 )
 
 func main() {
@@ -3,4 +3,5 @@ module github.com/rocksolidlabs/gofish
 require (
 	github.com/davecgh/go-spew v1.1.1
 	github.com/stmcginnis/gofish v0.1.0
+	gopkg.in/yaml.v3 v3.0.1
 )
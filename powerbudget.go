@@ -0,0 +1,306 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package gofish
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/rocksolidlabs/gofish/common"
+	"github.com/rocksolidlabs/gofish/redfish"
+)
+
+// PowerBudgetPolicy is how a power budget is shared between chassis.
+type PowerBudgetPolicy string
+
+const (
+	// ProportionalPowerBudgetPolicy shares the budget in proportion to the
+	// power demand of each chassis.
+	ProportionalPowerBudgetPolicy PowerBudgetPolicy = "Proportional"
+	// PriorityPowerBudgetPolicy shares the budget in proportion to the power
+	// demand of each chassis multiplied by its priority.
+	PriorityPowerBudgetPolicy PowerBudgetPolicy = "Priority"
+)
+
+// PowerBudgetNode is a chassis to include in a power budget.
+type PowerBudgetNode struct {
+	// Chassis is the chassis to cap.
+	Chassis *redfish.Chassis
+	// Priority weights the share of the chassis with the
+	// PriorityPowerBudgetPolicy. The default is 1.
+	Priority float32
+}
+
+// PowerAllocation is the planned power limit of a single chassis.
+type PowerAllocation struct {
+	// Chassis is the location of the chassis.
+	Chassis string
+	// Name is the name of the chassis.
+	Name string
+	// PowerControl is the MemberId of the PowerControl that is limited.
+	PowerControl string `json:",omitempty"`
+	// ConsumedWatts is the power the chassis currently consumes.
+	ConsumedWatts float32
+	// MaxConsumedWatts is the highest power the chassis consumed in the
+	// metrics interval of the service.
+	MaxConsumedWatts float32
+	// CapacityWatts is the power capacity of the chassis, zero if it is not
+	// known.
+	CapacityWatts float32
+	// CurrentLimitWatts is the power limit of the chassis before the plan
+	// is applied, zero if there is none.
+	CurrentLimitWatts float32
+	// DemandWatts is the power the chassis is expected to need, the
+	// highest consumption if known, otherwise the current consumption.
+	DemandWatts float32
+	// LimitInWatts is the planned power limit.
+	LimitInWatts float32
+	// Applied indicates the limit has been set on the chassis.
+	Applied bool
+	// Error describes why the chassis could not be planned or its limit
+	// could not be set.
+	Error string `json:",omitempty"`
+
+	power *redfish.Power
+}
+
+// PowerBudgetPlan is the planned power limits for a group of chassis. It
+// can be reviewed as a dry run before it is applied.
+type PowerBudgetPlan struct {
+	// Policy is how the budget was shared.
+	Policy PowerBudgetPolicy
+	// BudgetWatts is the total power budget.
+	BudgetWatts float32
+	// DemandWatts is the total demand of the planned chassis.
+	DemandWatts float32
+	// AllocatedWatts is the total of the planned limits.
+	AllocatedWatts float32
+	// Allocations has an entry for each chassis, in the order given.
+	Allocations []*PowerAllocation
+	// AllowUnconditionalPatch lets Apply set the limits of chassis whose
+	// services do not report ETags, see redfish.Power.
+	AllowUnconditionalPatch bool `json:"-"`
+}
+
+// PlanPowerBudget reads the power of each chassis and shares the budget
+// between them with the given policy. No chassis gets more than its
+// capacity, and the limits are rounded down to whole watts. Chassis whose
+// power cannot be read are reported with their Error set and get no share,
+// so their consumption should be left out of the budget. Nothing is changed
+// until the plan is applied.
+func PlanPowerBudget(nodes []PowerBudgetNode, budgetWatts float32, policy PowerBudgetPolicy, concurrency int) (*PowerBudgetPlan, error) {
+	if budgetWatts <= 0 {
+		return nil, fmt.Errorf("power budget must be positive, got %g W", budgetWatts)
+	}
+	if policy != ProportionalPowerBudgetPolicy && policy != PriorityPowerBudgetPolicy {
+		return nil, fmt.Errorf("unknown power budget policy %s", policy)
+	}
+
+	plan := &PowerBudgetPlan{
+		Policy:      policy,
+		BudgetWatts: budgetWatts,
+		Allocations: make([]*PowerAllocation, len(nodes)),
+	}
+
+	forEachConcurrently(len(nodes), concurrency, func(i int) {
+		plan.Allocations[i] = readPowerAllocation(nodes[i].Chassis)
+	})
+
+	weights := make([]float64, len(nodes))
+	for i, allocation := range plan.Allocations {
+		if allocation.Error != "" {
+			continue
+		}
+
+		plan.DemandWatts += allocation.DemandWatts
+		weights[i] = float64(allocation.DemandWatts)
+		if policy == PriorityPowerBudgetPolicy {
+			priority := nodes[i].Priority
+			if priority == 0 {
+				priority = 1
+			}
+			weights[i] *= float64(priority)
+		}
+	}
+
+	shares := sharePowerBudget(float64(budgetWatts), weights, plan.ceilings())
+	for i, allocation := range plan.Allocations {
+		if allocation.Error != "" {
+			continue
+		}
+
+		allocation.LimitInWatts = float32(math.Floor(shares[i]))
+		if allocation.LimitInWatts <= 0 {
+			allocation.Error = "budget too small to give the chassis any power"
+			continue
+		}
+		plan.AllocatedWatts += allocation.LimitInWatts
+	}
+
+	return plan, nil
+}
+
+// Apply sets the planned limits, running at most concurrency requests at
+// once. The exception is the action the chassis take if they cannot stay
+// below their limit, or empty to keep their current setting. Each
+// allocation records whether its limit was set.
+func (plan *PowerBudgetPlan) Apply(exception redfish.PowerLimitException, concurrency int) error {
+	var mutex sync.Mutex
+	failed := 0
+
+	forEachConcurrently(len(plan.Allocations), concurrency, func(i int) {
+		allocation := plan.Allocations[i]
+		if allocation.Error != "" || allocation.Applied {
+			return
+		}
+
+		allocation.power.AllowUnconditionalPatch = plan.AllowUnconditionalPatch
+		err := allocation.power.SetPowerLimit(allocation.PowerControl, allocation.LimitInWatts, exception, 0)
+		if err != nil {
+			allocation.Error = err.Error()
+			mutex.Lock()
+			failed++
+			mutex.Unlock()
+			return
+		}
+		allocation.Applied = true
+	})
+
+	if failed > 0 {
+		return fmt.Errorf("%d power limits could not be set", failed)
+	}
+	return nil
+}
+
+// ceilings gets the most power each chassis can be given, zero for no
+// limit.
+func (plan *PowerBudgetPlan) ceilings() []float64 {
+	result := make([]float64, len(plan.Allocations))
+	for i, allocation := range plan.Allocations {
+		result[i] = float64(allocation.CapacityWatts)
+	}
+	return result
+}
+
+// readPowerAllocation reads the power of the chassis from its first
+// PowerControl, which covers the whole chassis.
+func readPowerAllocation(chassis *redfish.Chassis) *PowerAllocation {
+	allocation := &PowerAllocation{
+		Chassis: chassis.ODataID,
+		Name:    chassis.Name,
+	}
+
+	power, err := chassis.Power()
+	if err != nil {
+		allocation.Error = err.Error()
+		return allocation
+	}
+	if power == nil || len(power.PowerControl) == 0 {
+		allocation.Error = "chassis does not report power control"
+		return allocation
+	}
+
+	control := power.PowerControl[0]
+	allocation.power = power
+	allocation.PowerControl = control.MemberID
+	allocation.ConsumedWatts, _ = common.Float32Value(control.PowerConsumedWatts)
+	allocation.MaxConsumedWatts, _ = common.Float32Value(control.PowerMetrics.MaxConsumedWatts)
+	allocation.CapacityWatts, _ = common.Float32Value(control.PowerCapacityWatts)
+	allocation.CurrentLimitWatts, _ = common.Float32Value(control.PowerLimit.LimitInWatts)
+
+	allocation.DemandWatts = allocation.MaxConsumedWatts
+	if allocation.DemandWatts < allocation.ConsumedWatts {
+		allocation.DemandWatts = allocation.ConsumedWatts
+	}
+	if allocation.DemandWatts <= 0 {
+		allocation.Error = "chassis does not report its power consumption"
+	}
+
+	return allocation
+}
+
+// sharePowerBudget shares the budget in proportion to the weights, giving
+// no share more than its ceiling. The budget left by shares that reach
+// their ceiling is shared between the others. Ceilings of zero are not
+// limited.
+func sharePowerBudget(budget float64, weights, ceilings []float64) []float64 {
+	shares := make([]float64, len(weights))
+	open := make([]bool, len(weights))
+	for i, weight := range weights {
+		open[i] = weight > 0
+	}
+
+	for {
+		total := 0.0
+		for i, weight := range weights {
+			if open[i] {
+				total += weight
+			}
+		}
+		if total == 0 || budget <= 0 {
+			return shares
+		}
+
+		capped := false
+		for i, weight := range weights {
+			if open[i] && ceilings[i] > 0 && budget*weight/total >= ceilings[i] {
+				shares[i] = ceilings[i]
+				budget -= ceilings[i]
+				open[i] = false
+				capped = true
+			}
+		}
+		if capped {
+			continue
+		}
+
+		for i, weight := range weights {
+			if open[i] {
+				shares[i] = budget * weight / total
+			}
+		}
+		return shares
+	}
+}
+
+// forEachConcurrently calls fn for each index below n, running at most
+// concurrency calls at once.
+func forEachConcurrently(n, concurrency int, fn func(i int)) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+// ContainedPowerBudgetNodes gets the chassis contained in a container such
+// as a rack, as power budget nodes with the default priority.
+func ContainedPowerBudgetNodes(container *redfish.Chassis) ([]PowerBudgetNode, error) {
+	contained, err := container.Contains()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]PowerBudgetNode, len(contained))
+	for i, chassis := range contained {
+		nodes[i] = PowerBudgetNode{Chassis: chassis}
+	}
+	return nodes, nil
+}
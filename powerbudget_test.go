@@ -0,0 +1,202 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package gofish
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/redfish"
+)
+
+// fakeRackServer is a rack of chassis that report their power.
+type fakeRackServer struct {
+	mutex   sync.Mutex
+	power   map[string]string
+	patches map[string]string
+}
+
+func newFakeRackServer() *fakeRackServer {
+	power := func(id string, consumed, max, capacity float32) string {
+		return fmt.Sprintf(`{
+			"@odata.id": "/redfish/v1/Chassis/%s/Power",
+			"@odata.etag": "1",
+			"PowerControl": [{
+				"MemberId": "0",
+				"PowerConsumedWatts": %g,
+				"PowerCapacityWatts": %g,
+				"PowerMetrics": {"MaxConsumedWatts": %g}
+			}]
+		}`, id, consumed, capacity, max)
+	}
+
+	return &fakeRackServer{
+		power: map[string]string{
+			"1": power("1", 300, 400, 500),
+			"2": power("2", 200, 200, 250),
+			"3": power("3", 100, 0, 0),
+		},
+		patches: make(map[string]string),
+	}
+}
+
+func (f *fakeRackServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	var id string
+	switch {
+	case r.URL.Path == "/redfish/v1/Chassis/Rack":
+		fmt.Fprint(w, `{
+			"@odata.id": "/redfish/v1/Chassis/Rack",
+			"ChassisType": "Rack",
+			"Links": {"Contains": [
+				{"@odata.id": "/redfish/v1/Chassis/1"},
+				{"@odata.id": "/redfish/v1/Chassis/2"},
+				{"@odata.id": "/redfish/v1/Chassis/3"}
+			]}
+		}`)
+	case r.Method == http.MethodGet && sscanPath(r.URL.Path, "/redfish/v1/Chassis/%1s/Power", &id):
+		fmt.Fprint(w, f.power[id])
+	case r.Method == http.MethodPatch && sscanPath(r.URL.Path, "/redfish/v1/Chassis/%1s/Power", &id):
+		if r.Header.Get("If-Match") != "1" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		f.patches[id] = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	case sscanPath(r.URL.Path, "/redfish/v1/Chassis/%1s", &id):
+		fmt.Fprintf(w, `{
+			"@odata.id": "/redfish/v1/Chassis/%s",
+			"Name": "Node %s",
+			"Power": {"@odata.id": "/redfish/v1/Chassis/%s/Power"}
+		}`, id, id, id)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// sscanPath matches the path against the format.
+func sscanPath(path, format string, id *string) bool {
+	n, err := fmt.Sscanf(path, format, id)
+	return err == nil && n == 1 && fmt.Sprintf(format, *id) == path
+}
+
+// rackNodes gets the chassis in the rack of the fake server.
+func rackNodes(t *testing.T) ([]PowerBudgetNode, *fakeRackServer) {
+	server := newFakeRackServer()
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+
+	client, err := APIClient(ts.URL, nil)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	rack, err := redfish.GetChassis(client, "/redfish/v1/Chassis/Rack")
+	if err != nil {
+		t.Fatalf("Error getting rack: %s", err)
+	}
+
+	nodes, err := ContainedPowerBudgetNodes(rack)
+	if err != nil {
+		t.Fatalf("Error getting rack nodes: %s", err)
+	}
+
+	return nodes, server
+}
+
+// TestPlanPowerBudgetProportional tests sharing a budget by demand.
+func TestPlanPowerBudgetProportional(t *testing.T) {
+	nodes, server := rackNodes(t)
+
+	plan, err := PlanPowerBudget(nodes, 600, ProportionalPowerBudgetPolicy, 2)
+	if err != nil {
+		t.Fatalf("Error planning power budget: %s", err)
+	}
+
+	if plan.DemandWatts != 700 {
+		t.Errorf("Expected demand of 700 W, got %g", plan.DemandWatts)
+	}
+
+	// Demands of 400, 200 and 100 W share 600 W.
+	expected := []float32{342, 171, 85}
+	for i, allocation := range plan.Allocations {
+		if allocation.Error != "" {
+			t.Errorf("Unexpected error for %s: %s", allocation.Name, allocation.Error)
+		}
+		if allocation.LimitInWatts != expected[i] {
+			t.Errorf("Expected %s to get %g W, got %g", allocation.Name, expected[i], allocation.LimitInWatts)
+		}
+	}
+
+	if plan.AllocatedWatts > plan.BudgetWatts {
+		t.Errorf("Allocated %g W of a %g W budget", plan.AllocatedWatts, plan.BudgetWatts)
+	}
+
+	if len(server.patches) != 0 {
+		t.Errorf("Planning should not change any limits: %v", server.patches)
+	}
+
+	err = plan.Apply(redfish.LogEventOnlyPowerLimitException, 2)
+	if err != nil {
+		t.Errorf("Error applying plan: %s", err)
+	}
+
+	if server.patches["2"] != `{"PowerControl":[{"PowerLimit":{"LimitInWatts":171,"LimitException":"LogEventOnly"}}]}` {
+		t.Errorf("Unexpected limit for chassis 2: %s", server.patches["2"])
+	}
+
+	for _, allocation := range plan.Allocations {
+		if !allocation.Applied {
+			t.Errorf("Limit of %s was not applied: %s", allocation.Name, allocation.Error)
+		}
+	}
+}
+
+// TestPlanPowerBudgetPriority tests that priorities and capacities are
+// respected.
+func TestPlanPowerBudgetPriority(t *testing.T) {
+	nodes, _ := rackNodes(t)
+	nodes[1].Priority = 4
+
+	plan, err := PlanPowerBudget(nodes, 650, PriorityPowerBudgetPolicy, 1)
+	if err != nil {
+		t.Fatalf("Error planning power budget: %s", err)
+	}
+
+	// Chassis 2 is capped at its 250 W capacity, the remaining 400 W is
+	// shared by demands of 400 and 100 W.
+	expected := []float32{320, 250, 80}
+	for i, allocation := range plan.Allocations {
+		if allocation.LimitInWatts != expected[i] {
+			t.Errorf("Expected %s to get %g W, got %g", allocation.Name, expected[i], allocation.LimitInWatts)
+		}
+	}
+}
+
+// TestSharePowerBudget tests sharing a budget larger than all ceilings.
+func TestSharePowerBudget(t *testing.T) {
+	shares := sharePowerBudget(1000, []float64{1, 1, 0}, []float64{100, 200, 300})
+	if shares[0] != 100 || shares[1] != 200 || shares[2] != 0 {
+		t.Errorf("Unexpected shares: %v", shares)
+	}
+
+	shares = sharePowerBudget(90, []float64{1, 2}, []float64{0, 0})
+	if math.Abs(shares[0]-30) > 1e-9 || math.Abs(shares[1]-60) > 1e-9 {
+		t.Errorf("Unexpected shares: %v", shares)
+	}
+
+	_, err := PlanPowerBudget(nil, 0, ProportionalPowerBudgetPolicy, 1)
+	if err == nil {
+		t.Error("Expected error for an empty budget")
+	}
+}
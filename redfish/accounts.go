@@ -6,22 +6,94 @@ package redfish
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
 
 	"github.com/rocksolidlabs/gofish/common"
 )
 
+// LocalAccountAuth is how the service uses local accounts during
+// authentication.
+type LocalAccountAuth string
+
+const (
+	// EnabledLocalAccountAuth shall authenticate users based on the account
+	// service-defined accounts collection.
+	EnabledLocalAccountAuth LocalAccountAuth = "Enabled"
+	// DisabledLocalAccountAuth shall never authenticate users based on the
+	// account service-defined accounts collection.
+	DisabledLocalAccountAuth LocalAccountAuth = "Disabled"
+	// FallbackLocalAccountAuth shall authenticate users based on the account
+	// service-defined accounts collection only if any external account
+	// providers are currently unreachable.
+	FallbackLocalAccountAuth LocalAccountAuth = "Fallback"
+	// LocalFirstLocalAccountAuth shall first authenticate users based on the
+	// account service-defined accounts collection. If authentication fails,
+	// the service shall authenticate by using external account providers.
+	LocalFirstLocalAccountAuth LocalAccountAuth = "LocalFirst"
+)
+
 // AccountService contains properties for managing user accounts. The
 // properties are common to all user accounts, such as password requirements,
 // and control features such as account lockout. The schema also contains links
 // to the collections of Manager Accounts and Roles.
 type AccountService struct {
 	common.Entity
-	Description                 string
-	Modified                    string
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// AccountLockoutCounterResetAfter shall contain the period of time, in
+	// seconds, from the last failed login attempt when the
+	// AccountLockoutThreshold counter, which counts the number of failed
+	// login attempts, is reset to zero.
+	AccountLockoutCounterResetAfter int
+	// AccountLockoutDuration shall contain the period of time, in seconds,
+	// that an account is locked after the number of failed login attempts
+	// reaches the AccountLockoutThreshold value. A value of zero indicates
+	// the account stays locked until an administrator unlocks it.
+	AccountLockoutDuration int
+	// AccountLockoutThreshold shall contain the threshold of failed login
+	// attempts before a user account is locked. A value of zero indicates
+	// the account is never locked.
+	AccountLockoutThreshold int
+	// ActiveDirectory shall contain the first Active Directory external
+	// account provider that this account service supports.
+	ActiveDirectory ExternalAccountProviderSettings
+	// AuthFailureLoggingThreshold shall contain the threshold for when an
+	// authorization failure is logged.
 	AuthFailureLoggingThreshold int
-	MinPasswordLength           int
-	accounts                    string
-	roles                       string
+	// Description provides a description of this resource.
+	Description string
+	// LDAP shall contain the first LDAP external account provider that this
+	// account service supports.
+	LDAP ExternalAccountProviderSettings
+	// LocalAccountAuth shall govern how the service uses the accounts
+	// collection within this account service as part of authentication.
+	LocalAccountAuth LocalAccountAuth
+	// MaxPasswordLength shall contain the maximum password length that the
+	// implementation allows for this account service.
+	MaxPasswordLength int
+	// MinPasswordLength shall contain the minimum password length that the
+	// implementation allows for this account service.
+	MinPasswordLength int
+	Modified          string
+	// ServiceEnabled shall indicate whether the account service is enabled.
+	ServiceEnabled bool
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// rawData holds the original serialized JSON so we can compare updates.
+	rawData  []byte
+	accounts string
+	roles    string
+	// additionalExternalAccountProviders shall contain a link to a resource
+	// collection of type ExternalAccountProviderCollection.
+	additionalExternalAccountProviders string
 }
 
 // UnmarshalJSON unmarshals an AccountService object from the raw JSON.
@@ -33,7 +105,10 @@ func (as *AccountService) UnmarshalJSON(b []byte) error {
 	}
 	var t struct {
 		temp
-		Links AccountLinks
+		Accounts                           common.Link
+		AdditionalExternalAccountProviders common.Link
+		Roles                              common.Link
+		Links                              AccountLinks
 	}
 
 	err := json.Unmarshal(b, &t)
@@ -43,13 +118,53 @@ func (as *AccountService) UnmarshalJSON(b []byte) error {
 
 	*as = AccountService(t.temp)
 
-	// Extract the links to other entities for later
-	as.accounts = string(t.Links.Accounts)
-	as.roles = string(t.Links.Roles)
+	// Extract the links to other entities for later. Older services list
+	// the collections under Links.
+	as.accounts = string(t.Accounts)
+	if as.accounts == "" {
+		as.accounts = string(t.Links.Accounts)
+	}
+	as.roles = string(t.Roles)
+	if as.roles == "" {
+		as.roles = string(t.Links.Roles)
+	}
+	as.additionalExternalAccountProviders = string(t.AdditionalExternalAccountProviders)
+
+	// This is a read/write object, so we need to save the raw object data for later
+	as.rawData = b
 
 	return nil
 }
 
+// Update commits updates to this object's properties to the running system.
+func (as *AccountService) Update() error {
+	// Get a representation of the object's original state so we can find what
+	// to update.
+	original := new(AccountService)
+	err := original.UnmarshalJSON(as.rawData)
+	if err != nil {
+		return err
+	}
+
+	readWriteFields := []string{
+		"AccountLockoutCounterResetAfter",
+		"AccountLockoutDuration",
+		"AccountLockoutThreshold",
+		"ActiveDirectory",
+		"AuthFailureLoggingThreshold",
+		"LDAP",
+		"LocalAccountAuth",
+		"MaxPasswordLength",
+		"MinPasswordLength",
+		"ServiceEnabled",
+	}
+
+	originalElement := reflect.ValueOf(original).Elem()
+	currentElement := reflect.ValueOf(as).Elem()
+
+	return as.Entity.Update(as.ODataID, originalElement, currentElement, readWriteFields, &as.rawData)
+}
+
 // GetAccountService will get the AccountService instance from the Redfish
 // service.
 func GetAccountService(c common.Client, uri string) (*AccountService, error) {
@@ -79,9 +194,189 @@ func (as *AccountService) Roles() ([]*Role, error) {
 	return ListReferencedRoles(as.Client, as.roles)
 }
 
+// AdditionalExternalAccountProviders gets the external account providers
+// beyond the LDAP and ActiveDirectory settings of the account service.
+func (as *AccountService) AdditionalExternalAccountProviders() ([]*ExternalAccountProvider, error) {
+	return ListReferencedExternalAccountProviders(as.Client, as.additionalExternalAccountProviders)
+}
+
+// CreateExternalAccountProvider adds an external account provider to the
+// service. The provider type and its writable settings are sent.
+func (as *AccountService) CreateExternalAccountProvider(provider *ExternalAccountProvider) (*ExternalAccountProvider, error) {
+	if as.additionalExternalAccountProviders == "" {
+		return nil, fmt.Errorf("account service does not support additional external account providers")
+	}
+
+	t := provider.settings().payload()
+	t.AccountProviderType = provider.AccountProviderType
+
+	resp, err := as.Post(as.additionalExternalAccountProviders, t)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if location := resp.Header.Get("Location"); location != "" {
+		return GetExternalAccountProvider(as.Client, location)
+	}
+
+	var created ExternalAccountProvider
+	err = json.NewDecoder(resp.Body).Decode(&created)
+	if err != nil {
+		return nil, err
+	}
+
+	created.SetClient(as.Client)
+	return &created, nil
+}
+
+// LDAPCertificates gets the certificates used to connect to the LDAP
+// service.
+func (as *AccountService) LDAPCertificates() ([]*Certificate, error) {
+	return ListReferencedCertificates(as.Client, as.LDAP.certificates)
+}
+
+// ActiveDirectoryCertificates gets the certificates used to connect to the
+// Active Directory service.
+func (as *AccountService) ActiveDirectoryCertificates() ([]*Certificate, error) {
+	return ListReferencedCertificates(as.Client, as.ActiveDirectory.certificates)
+}
+
+// FixedAccountSlots reports whether the service has a fixed number of
+// account slots. Such services list every slot in the accounts collection,
+// with unused slots having an empty user name, and accounts are created by
+// updating a free slot rather than by posting to the collection.
+func (as *AccountService) FixedAccountSlots() (bool, error) {
+	accounts, err := as.Accounts()
+	if err != nil {
+		return false, err
+	}
+
+	return len(freeAccountSlots(accounts)) > 0, nil
+}
+
+// freeAccountSlots gets the unused slots of a fixed slot account layout.
+func freeAccountSlots(accounts []*Account) []*Account {
+	var result []*Account
+	for _, account := range accounts {
+		if account.UserName == "" && !account.Enabled {
+			result = append(result, account)
+		}
+	}
+	return result
+}
+
+// CreateAccount creates a new enabled account with the given role. On
+// services with a fixed slot layout the first free slot that accepts the
+// account is used.
+func (as *AccountService) CreateAccount(username, password, roleID string) (*Account, error) {
+	if username == "" {
+		return nil, fmt.Errorf("a user name is required to create an account")
+	}
+
+	accounts, err := as.Accounts()
+	if err != nil {
+		return nil, err
+	}
+
+	t := struct {
+		UserName string
+		Password string
+		RoleID   string `json:"RoleId"`
+		Enabled  bool
+	}{
+		UserName: username,
+		Password: password,
+		RoleID:   roleID,
+		Enabled:  true,
+	}
+
+	slots := freeAccountSlots(accounts)
+	if len(slots) == 0 {
+		resp, err := as.Post(as.accounts, t)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if location := resp.Header.Get("Location"); location != "" {
+			return GetAccount(as.Client, location)
+		}
+
+		var account Account
+		err = json.NewDecoder(resp.Body).Decode(&account)
+		if err != nil {
+			return nil, err
+		}
+
+		account.SetClient(as.Client)
+		return &account, nil
+	}
+
+	// Some services reserve slots that cannot be assigned, so keep trying
+	// until one of them accepts the account.
+	for _, slot := range slots {
+		resp, patchErr := as.Patch(slot.ODataID, t)
+		if patchErr != nil {
+			err = patchErr
+			continue
+		}
+		resp.Body.Close()
+
+		return GetAccount(as.Client, slot.ODataID)
+	}
+
+	return nil, err
+}
+
+// CreateRole creates a custom role with the given privileges.
+func (as *AccountService) CreateRole(roleID string, assignedPrivileges, oemPrivileges []string) (*Role, error) {
+	if as.roles == "" {
+		return nil, fmt.Errorf("account service does not support roles")
+	}
+
+	t := struct {
+		RoleID             string   `json:"RoleId"`
+		AssignedPrivileges []string `json:",omitempty"`
+		OEMPrivileges      []string `json:"OemPrivileges,omitempty"`
+	}{
+		RoleID:             roleID,
+		AssignedPrivileges: assignedPrivileges,
+		OEMPrivileges:      oemPrivileges,
+	}
+
+	resp, err := as.Post(as.roles, t)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if location := resp.Header.Get("Location"); location != "" {
+		return GetRole(as.Client, location)
+	}
+
+	var role Role
+	err = json.NewDecoder(resp.Body).Decode(&role)
+	if err != nil {
+		return nil, err
+	}
+
+	role.SetClient(as.Client)
+	return &role, nil
+}
+
 // Account is a Redfish account
 type Account struct {
 	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType   string `json:"@odata.type"`
 	Modified    string
 	Description string
 	Password    string
@@ -90,6 +385,11 @@ type Account struct {
 	Enabled     bool
 	RoleID      string `json:"RoleId"`
 	role        string
+	// certificates shall contain a link to a resource collection of type
+	// CertificateCollection.
+	certificates string
+	// rawData holds the original serialized JSON so we can compare updates.
+	rawData []byte
 }
 
 // UnmarshalJSON unmarshals an Account object from the raw JSON.
@@ -100,7 +400,8 @@ func (s *Account) UnmarshalJSON(b []byte) error {
 	}
 	var t struct {
 		temp
-		Links AccountLinks
+		Certificates common.Link
+		Links        AccountLinks
 	}
 
 	err := json.Unmarshal(b, &t)
@@ -112,7 +413,67 @@ func (s *Account) UnmarshalJSON(b []byte) error {
 
 	// Extract the links to other entities for later
 	s.role = string(t.Links.Role)
+	s.certificates = string(t.Certificates)
+
+	// This is a read/write object, so we need to save the raw object data for later
+	s.rawData = b
+
+	return nil
+}
+
+// Update commits updates to this object's properties to the running system.
+// The password is only sent when it has been set.
+func (s *Account) Update() error {
+	// Get a representation of the object's original state so we can find what
+	// to update.
+	original := new(Account)
+	err := original.UnmarshalJSON(s.rawData)
+	if err != nil {
+		return err
+	}
+
+	readWriteFields := []string{
+		"Enabled",
+		"Locked",
+		"Password",
+		"RoleID",
+		"UserName",
+	}
+
+	originalElement := reflect.ValueOf(original).Elem()
+	currentElement := reflect.ValueOf(s).Elem()
+
+	return s.Entity.Update(s.ODataID, originalElement, currentElement, readWriteFields, &s.rawData)
+}
+
+// Delete removes the account from the service. Services with a fixed slot
+// layout do not allow accounts to be deleted, use ClearSlot instead.
+func (s *Account) Delete() error {
+	resp, err := s.Client.Delete(s.ODataID)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ClearSlot frees the slot of this account on services with a fixed slot
+// layout by disabling it and clearing its user name.
+func (s *Account) ClearSlot() error {
+	t := struct {
+		UserName string
+		Enabled  bool
+	}{}
+
+	resp, err := s.Patch(s.ODataID, t)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
 
+	s.UserName = ""
+	s.Enabled = false
 	return nil
 }
 
@@ -130,6 +491,7 @@ func GetAccount(c common.Client, uri string) (*Account, error) {
 		return nil, err
 	}
 
+	t.SetClient(c)
 	return &t, nil
 }
 
@@ -152,14 +514,92 @@ func ListReferencedAccounts(c common.Client, link string) ([]*Account, error) {
 	return result, nil
 }
 
+// Certificates gets the certificates of this account, used for client
+// certificate authentication.
+func (s *Account) Certificates() ([]*Certificate, error) {
+	return ListReferencedCertificates(s.Client, s.certificates)
+}
+
 // Role is a Redfish role
 type Role struct {
 	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType          string `json:"@odata.type"`
 	Modified           string
 	Description        string
 	IsPredefined       bool
 	AssignedPrivileges []string
-	OEMPrivileges      []string
+	OEMPrivileges      []string `json:"OemPrivileges"`
+	// RoleID shall contain the string name of the role.
+	RoleID string `json:"RoleId"`
+	// rawData holds the original serialized JSON so we can compare updates.
+	rawData []byte
+}
+
+// UnmarshalJSON unmarshals a Role object from the raw JSON.
+func (role *Role) UnmarshalJSON(b []byte) error {
+	type temp Role
+	var t temp
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*role = Role(t)
+
+	// This is a read/write object, so we need to save the raw object data for later
+	role.rawData = b
+
+	return nil
+}
+
+// Update commits updates to this object's properties to the running system.
+// Predefined roles cannot be changed.
+func (role *Role) Update() error {
+	if role.IsPredefined {
+		return fmt.Errorf("predefined role %s cannot be changed", role.ID)
+	}
+
+	// Get a representation of the object's original state so we can find what
+	// to update.
+	original := new(Role)
+	err := original.UnmarshalJSON(role.rawData)
+	if err != nil {
+		return err
+	}
+
+	readWriteFields := []string{
+		"AssignedPrivileges",
+		"OEMPrivileges",
+	}
+
+	originalElement := reflect.ValueOf(original).Elem()
+	currentElement := reflect.ValueOf(role).Elem()
+
+	return role.Entity.Update(role.ODataID, originalElement, currentElement, readWriteFields, &role.rawData)
+}
+
+// Delete removes a custom role from the service.
+func (role *Role) Delete() error {
+	if role.IsPredefined {
+		return fmt.Errorf("predefined role %s cannot be deleted", role.ID)
+	}
+
+	resp, err := role.Client.Delete(role.ODataID)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
 }
 
 // GetRole will get a role instance from the Redfish service.
@@ -176,6 +616,7 @@ func GetRole(c common.Client, uri string) (*Role, error) {
 		return nil, err
 	}
 
+	t.SetClient(c)
 	return &t, nil
 }
 
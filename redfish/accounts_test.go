@@ -6,8 +6,12 @@ package redfish
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
 	"strings"
 	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
 )
 
 var accountBody = strings.NewReader(
@@ -65,7 +69,7 @@ var roleBody = strings.NewReader(
 		"AssignedPrivileges": [
 			"Login"
 		],
-		"OEMPrivileges": []
+		"OemPrivileges": []
 	}`)
 
 // TestAccount tests the parsing of Account objects.
@@ -158,3 +162,383 @@ func TestRole(t *testing.T) {
 		t.Errorf("Expected 'Login' assigned privilege, got: %s", result.AssignedPrivileges[0])
 	}
 }
+
+var accountsCollectionBody = `{
+		"@odata.id": "/redfish/v1/AccountService/Accounts",
+		"Members": [
+			{"@odata.id": "/redfish/v1/AccountService/Accounts/1"},
+			{"@odata.id": "/redfish/v1/AccountService/Accounts/2"},
+			{"@odata.id": "/redfish/v1/AccountService/Accounts/3"}
+		],
+		"Members@odata.count": 3
+	}`
+
+// slotAccount returns the body of an account in a fixed slot layout.
+func slotAccount(id, username string, enabled bool) string {
+	return fmt.Sprintf(`{
+		"@odata.id": "/redfish/v1/AccountService/Accounts/%s",
+		"Id": "%s",
+		"UserName": "%s",
+		"Enabled": %t,
+		"RoleId": "None"
+	}`, id, id, username, enabled)
+}
+
+// TestAccountServiceUpdate tests updating the account service settings.
+func TestAccountServiceUpdate(t *testing.T) {
+	var result AccountService
+	err := json.NewDecoder(strings.NewReader(`{
+		"@odata.id": "/redfish/v1/AccountService",
+		"Id": "AccountService",
+		"MinPasswordLength": 8,
+		"AccountLockoutThreshold": 5,
+		"AuthFailureLoggingThreshold": 3,
+		"Accounts": {"@odata.id": "/redfish/v1/AccountService/Accounts"},
+		"Roles": {"@odata.id": "/redfish/v1/AccountService/Roles"}
+	}`)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.accounts != "/redfish/v1/AccountService/Accounts" {
+		t.Errorf("Received invalid Accounts: %s", result.accounts)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	result.MinPasswordLength = 12
+	result.AccountLockoutThreshold = 3
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+
+	expected := `{"AccountLockoutThreshold":3,"MinPasswordLength":12}`
+	if client.calls[0].URL != "/redfish/v1/AccountService" || client.calls[0].Payload != expected {
+		t.Errorf("Unexpected update request: %s %s", client.calls[0].URL, client.calls[0].Payload)
+	}
+}
+
+// TestCreateAccount tests creating accounts by posting to the collection.
+func TestCreateAccount(t *testing.T) {
+	client := &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/AccountService/Accounts":   accountsCollectionBody,
+			"GET /redfish/v1/AccountService/Accounts/1": slotAccount("1", "root", true),
+			"GET /redfish/v1/AccountService/Accounts/2": slotAccount("2", "admin", true),
+			"GET /redfish/v1/AccountService/Accounts/3": slotAccount("3", "operator", true),
+			"GET /redfish/v1/AccountService/Accounts/4": slotAccount("4", "newuser", true),
+		},
+		headers: map[string]http.Header{
+			"POST /redfish/v1/AccountService/Accounts": {"Location": []string{"/redfish/v1/AccountService/Accounts/4"}},
+		},
+	}
+
+	as := AccountService{accounts: "/redfish/v1/AccountService/Accounts"}
+	as.SetClient(client)
+
+	fixed, err := as.FixedAccountSlots()
+	if err != nil || fixed {
+		t.Errorf("Expected no fixed account slots: %v", err)
+	}
+
+	account, err := as.CreateAccount("newuser", "secret", "Operator")
+	if err != nil {
+		t.Fatalf("Error creating account: %s", err)
+	}
+
+	call := client.calls[len(client.calls)-2]
+	expected := `{"UserName":"newuser","Password":"secret","RoleId":"Operator","Enabled":true}`
+	if call.Method != http.MethodPost || call.Payload != expected {
+		t.Errorf("Unexpected create request: %s %s", call.Method, call.Payload)
+	}
+
+	if account.ID != "4" {
+		t.Errorf("Received invalid account: %s", account.ID)
+	}
+}
+
+// TestCreateAccountFixedSlots tests creating accounts on services with a
+// fixed slot layout.
+func TestCreateAccountFixedSlots(t *testing.T) {
+	client := &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/AccountService/Accounts":   accountsCollectionBody,
+			"GET /redfish/v1/AccountService/Accounts/1": slotAccount("1", "", false),
+			"GET /redfish/v1/AccountService/Accounts/2": slotAccount("2", "root", true),
+			"GET /redfish/v1/AccountService/Accounts/3": slotAccount("3", "", false),
+		},
+	}
+
+	as := AccountService{accounts: "/redfish/v1/AccountService/Accounts"}
+	as.SetClient(client)
+
+	fixed, err := as.FixedAccountSlots()
+	if err != nil || !fixed {
+		t.Errorf("Expected fixed account slots: %v", err)
+	}
+
+	client.calls = nil
+	_, err = as.CreateAccount("newuser", "secret", "Operator")
+	if err != nil {
+		t.Fatalf("Error creating account: %s", err)
+	}
+
+	patch := client.calls[4]
+	expected := `{"UserName":"newuser","Password":"secret","RoleId":"Operator","Enabled":true}`
+	if patch.Method != http.MethodPatch || patch.URL != "/redfish/v1/AccountService/Accounts/1" || patch.Payload != expected {
+		t.Errorf("Unexpected slot request: %s %s %s", patch.Method, patch.URL, patch.Payload)
+	}
+
+	for _, call := range client.calls {
+		if call.Method == http.MethodPost {
+			t.Errorf("Unexpected POST to %s", call.URL)
+		}
+	}
+}
+
+// TestAccountUpdate tests updating and removing accounts.
+func TestAccountUpdate(t *testing.T) {
+	var result Account
+	err := json.NewDecoder(strings.NewReader(slotAccount("2", "operator", true))).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	result.Password = "new-secret"
+	result.RoleID = "Administrator"
+	result.Locked = true
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+
+	expected := `{"Locked":true,"Password":"new-secret","RoleId":"Administrator"}`
+	if client.calls[0].Payload != expected {
+		t.Errorf("Unexpected update payload: %s", client.calls[0].Payload)
+	}
+
+	err = result.ClearSlot()
+	if err != nil {
+		t.Errorf("Error clearing slot: %s", err)
+	}
+
+	if client.calls[1].Payload != `{"UserName":"","Enabled":false}` {
+		t.Errorf("Unexpected clear payload: %s", client.calls[1].Payload)
+	}
+
+	err = result.Delete()
+	if err != nil {
+		t.Errorf("Error deleting account: %s", err)
+	}
+
+	if client.calls[2].Method != http.MethodDelete || client.calls[2].URL != "/redfish/v1/AccountService/Accounts/2" {
+		t.Errorf("Unexpected delete request: %s %s", client.calls[2].Method, client.calls[2].URL)
+	}
+}
+
+// TestCreateRole tests creating and updating custom roles.
+func TestCreateRole(t *testing.T) {
+	client := &testClient{
+		responses: map[string]string{
+			"POST /redfish/v1/AccountService/Roles": `{
+				"@odata.id": "/redfish/v1/AccountService/Roles/Auditor",
+				"Id": "Auditor",
+				"RoleId": "Auditor",
+				"IsPredefined": false,
+				"AssignedPrivileges": ["Login"]
+			}`,
+		},
+	}
+
+	as := AccountService{roles: "/redfish/v1/AccountService/Roles"}
+	as.SetClient(client)
+
+	role, err := as.CreateRole("Auditor", []string{"Login"}, nil)
+	if err != nil {
+		t.Fatalf("Error creating role: %s", err)
+	}
+
+	if client.calls[0].Payload != `{"RoleId":"Auditor","AssignedPrivileges":["Login"]}` {
+		t.Errorf("Unexpected create payload: %s", client.calls[0].Payload)
+	}
+
+	role.AssignedPrivileges = append(role.AssignedPrivileges, "ConfigureSelf")
+	err = role.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+
+	if client.calls[1].URL != "/redfish/v1/AccountService/Roles/Auditor" ||
+		client.calls[1].Payload != `{"AssignedPrivileges":["Login","ConfigureSelf"]}` {
+		t.Errorf("Unexpected update request: %s %s", client.calls[1].URL, client.calls[1].Payload)
+	}
+
+	_, err = as.CreateRole("Operator", []string{"Login"}, []string{"OemClearLog"})
+	if err != nil {
+		t.Fatalf("Error creating role: %s", err)
+	}
+
+	if client.calls[2].Payload != `{"RoleId":"Operator","AssignedPrivileges":["Login"],"OemPrivileges":["OemClearLog"]}` {
+		t.Errorf("Unexpected create payload: %s", client.calls[2].Payload)
+	}
+
+	var custom Role
+	err = json.Unmarshal([]byte(`{"@odata.id": "/redfish/v1/AccountService/Roles/Operator", "OemPrivileges": []}`), &custom)
+	if err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+	custom.SetClient(client)
+
+	custom.OEMPrivileges = []string{"OemClearLog"}
+	err = custom.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+
+	if client.calls[3].Payload != `{"OemPrivileges":["OemClearLog"]}` {
+		t.Errorf("Unexpected update payload: %s", client.calls[3].Payload)
+	}
+
+	predefined := Role{IsPredefined: true}
+	if predefined.Delete() == nil {
+		t.Error("Expected an error deleting a predefined role")
+	}
+}
+
+var externalAccountServiceBody = `{
+		"@odata.id": "/redfish/v1/AccountService",
+		"Id": "AccountService",
+		"LocalAccountAuth": "Enabled",
+		"LDAP": {
+			"AccountProviderType": "LDAPService",
+			"ServiceEnabled": false,
+			"ServiceAddresses": [
+				"ldaps://ldap.example.org:636"
+			],
+			"Authentication": {
+				"AuthenticationType": "UsernameAndPassword",
+				"Username": "cn=Manager,dc=example,dc=org",
+				"Password": null
+			},
+			"PasswordSet": true,
+			"Certificates": {
+				"@odata.id": "/redfish/v1/AccountService/LDAP/Certificates"
+			},
+			"RemoteRoleMapping": [
+				{
+					"RemoteUser": "cn=Manager,dc=example,dc=org",
+					"LocalRole": "Administrator"
+				}
+			],
+			"LDAPService": {
+				"SearchSettings": {
+					"BaseDistinguishedNames": [
+						"dc=example,dc=org"
+					],
+					"UsernameAttribute": "uid",
+					"GroupsAttribute": "memberof"
+				}
+			}
+		},
+		"ActiveDirectory": {
+			"AccountProviderType": "ActiveDirectoryService",
+			"ServiceEnabled": true,
+			"ServiceAddresses": [
+				"ad1.example.org"
+			],
+			"Authentication": {
+				"AuthenticationType": "KerberosKeytab",
+				"KerberosKeytab": null
+			}
+		},
+		"AdditionalExternalAccountProviders": {
+			"@odata.id": "/redfish/v1/AccountService/ExternalAccountProviders"
+		}
+	}`
+
+// TestAccountServiceExternalProviders tests parsing and updating the
+// external account provider settings.
+func TestAccountServiceExternalProviders(t *testing.T) {
+	var result AccountService
+	err := json.NewDecoder(strings.NewReader(externalAccountServiceBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.LDAP.AccountProviderType != LDAPServiceAccountProviderTypes || !result.LDAP.PasswordSet {
+		t.Errorf("Invalid LDAP settings: %v", result.LDAP)
+	}
+
+	if result.LDAP.LDAPService.SearchSettings.BaseDistinguishedNames[0] != "dc=example,dc=org" {
+		t.Errorf("Invalid LDAP search settings: %v", result.LDAP.LDAPService.SearchSettings)
+	}
+
+	if result.LDAP.certificates != "/redfish/v1/AccountService/LDAP/Certificates" {
+		t.Errorf("Invalid LDAP certificates: %s", result.LDAP.certificates)
+	}
+
+	if result.ActiveDirectory.Authentication.AuthenticationType != KerberosKeytabAuthenticationTypes {
+		t.Errorf("Invalid Active Directory authentication: %v", result.ActiveDirectory.Authentication)
+	}
+
+	if result.additionalExternalAccountProviders != "/redfish/v1/AccountService/ExternalAccountProviders" {
+		t.Errorf("Invalid external account providers: %s", result.additionalExternalAccountProviders)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	result.LDAP.ServiceEnabled = true
+	result.LDAP.Priority = common.Int(0)
+	result.LocalAccountAuth = FallbackLocalAccountAuth
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+
+	expected := `{"LDAP":{"Authentication":{"AuthenticationType":"UsernameAndPassword","Username":"cn=Manager,dc=example,dc=org"},` +
+		`"LDAPService":{"SearchSettings":{"BaseDistinguishedNames":["dc=example,dc=org"],"GroupsAttribute":"memberof","UsernameAttribute":"uid"}},"Priority":0,` +
+		`"RemoteRoleMapping":[{"LocalRole":"Administrator","RemoteUser":"cn=Manager,dc=example,dc=org"}],` +
+		`"ServiceAddresses":["ldaps://ldap.example.org:636"],"ServiceEnabled":true},"LocalAccountAuth":"Fallback"}`
+	if client.calls[0].Payload != expected {
+		t.Errorf("Unexpected update payload: %s", client.calls[0].Payload)
+	}
+}
+
+// TestCreateExternalAccountProvider tests adding an external account provider.
+func TestCreateExternalAccountProvider(t *testing.T) {
+	client := &testClient{
+		responses: map[string]string{
+			"POST /redfish/v1/AccountService/ExternalAccountProviders": externalAccountProviderBody,
+		},
+	}
+
+	as := AccountService{additionalExternalAccountProviders: "/redfish/v1/AccountService/ExternalAccountProviders"}
+	as.SetClient(client)
+
+	provider, err := as.CreateExternalAccountProvider(&ExternalAccountProvider{
+		AccountProviderType: RedfishServiceAccountProviderTypes,
+		ServiceAddresses:    []string{"http://redfish.dmtf.org/redfish/v1/AccountService"},
+		ServiceEnabled:      true,
+		Authentication:      Authentication{AuthenticationType: TokenAuthenticationTypes, Token: "token"},
+		Priority:            common.Int(0),
+	})
+	if err != nil {
+		t.Fatalf("Error creating provider: %s", err)
+	}
+
+	expected := `{"AccountProviderType":"RedfishService","Authentication":{"AuthenticationType":"Token","Token":"token"},"Priority":0,` +
+		`"ServiceAddresses":["http://redfish.dmtf.org/redfish/v1/AccountService"],"ServiceEnabled":true}`
+	if client.calls[0].Payload != expected {
+		t.Errorf("Unexpected create payload: %s", client.calls[0].Payload)
+	}
+
+	if provider.ID != "ExternalRedfishService" {
+		t.Errorf("Received invalid provider: %s", provider.ID)
+	}
+}
@@ -0,0 +1,200 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// AddressRange shall contain an address range.
+type AddressRange struct {
+	// Lower shall contain the lower address of the range.
+	Lower string
+	// Upper shall contain the upper address of the range.
+	Upper string
+}
+
+// VLANIdentifierAddressRange shall contain a range of VLAN identifiers.
+type VLANIdentifierAddressRange struct {
+	// Lower shall contain the lower VLAN identifier of the range.
+	Lower int
+	// Upper shall contain the upper VLAN identifier of the range.
+	Upper int
+}
+
+// IPv4AddressPool shall contain the IPv4 related properties of an Ethernet
+// fabric.
+type IPv4AddressPool struct {
+	// AnycastGatewayIPAddress shall contain the anycast gateway IPv4 address
+	// for a host subnet.
+	AnycastGatewayIPAddress string
+	// AnycastGatewayMACAddress shall contain the anycast gateway MAC address
+	// for a host subnet.
+	AnycastGatewayMACAddress string
+	// GatewayIPAddress shall contain the IPv4 address of the default gateway.
+	GatewayIPAddress string
+	// HostAddressRange shall contain the range of IPv4 addresses assigned to
+	// hosts.
+	HostAddressRange AddressRange
+	// NetworkAddressRange shall contain the range of IPv4 addresses assigned
+	// to network devices.
+	NetworkAddressRange AddressRange
+	// VLANIdentifierAddressRange shall contain the range of VLAN identifiers.
+	VLANIdentifierAddressRange VLANIdentifierAddressRange
+}
+
+// EthernetAddressPool shall contain the Ethernet related properties of an
+// address pool.
+type EthernetAddressPool struct {
+	// IPv4 shall contain the IPv4 related properties of the address pool.
+	IPv4 IPv4AddressPool
+}
+
+// GenZAddressPool shall contain the Gen-Z related properties of an address
+// pool.
+type GenZAddressPool struct {
+	// AccessKey shall contain the Gen-Z Core Specification-defined 6-bit
+	// access key for the address pool.
+	AccessKey string
+	// MaxCID shall contain the maximum value for the Gen-Z Core
+	// Specification-defined component identifier.
+	MaxCID int
+	// MaxSID shall contain the maximum value for the Gen-Z Core
+	// Specification-defined subnet identifier.
+	MaxSID int
+	// MinCID shall contain the minimum value for the Gen-Z Core
+	// Specification-defined component identifier.
+	MinCID int
+	// MinSID shall contain the minimum value for the Gen-Z Core
+	// Specification-defined subnet identifier.
+	MinSID int
+}
+
+// AddressPool shall contain a set of addresses to be assigned by a fabric.
+type AddressPool struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// Ethernet shall contain the Ethernet related properties of the address
+	// pool.
+	Ethernet EthernetAddressPool
+	// GenZ shall contain the Gen-Z related properties of the address pool.
+	GenZ GenZAddressPool
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// endpoints shall contain an array of links to resources of type
+	// Endpoint that this address pool contains.
+	endpoints []string
+	// zones shall contain an array of links to resources of type Zone that
+	// this address pool contains.
+	zones []string
+}
+
+// UnmarshalJSON unmarshals an AddressPool object from the raw JSON.
+func (addresspool *AddressPool) UnmarshalJSON(b []byte) error {
+	type temp AddressPool
+	type links struct {
+		Endpoints common.Links
+		Zones     common.Links
+	}
+	var t struct {
+		temp
+		Links links
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*addresspool = AddressPool(t.temp)
+
+	// Extract the links to other entities for later
+	addresspool.endpoints = t.Links.Endpoints.ToStrings()
+	addresspool.zones = t.Links.Zones.ToStrings()
+
+	return nil
+}
+
+// GetAddressPool will get an AddressPool instance from the service.
+func GetAddressPool(c common.Client, uri string) (*AddressPool, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var addresspool AddressPool
+	err = json.NewDecoder(resp.Body).Decode(&addresspool)
+	if err != nil {
+		return nil, err
+	}
+
+	addresspool.SetClient(c)
+	return &addresspool, nil
+}
+
+// ListReferencedAddressPools gets the collection of AddressPool from
+// a provided reference.
+func ListReferencedAddressPools(c common.Client, link string) ([]*AddressPool, error) {
+	var result []*AddressPool
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, addresspoolLink := range links.ItemLinks {
+		addresspool, err := GetAddressPool(c, addresspoolLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, addresspool)
+	}
+
+	return result, nil
+}
+
+// Endpoints gets the endpoints in the address pool.
+func (addresspool *AddressPool) Endpoints() ([]*Endpoint, error) {
+	var result []*Endpoint
+	for _, link := range addresspool.endpoints {
+		endpoint, err := GetEndpoint(addresspool.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, endpoint)
+	}
+
+	return result, nil
+}
+
+// Zones gets the zones in the address pool.
+func (addresspool *AddressPool) Zones() ([]*Zone, error) {
+	var result []*Zone
+	for _, link := range addresspool.zones {
+		zone, err := GetZone(addresspool.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, zone)
+	}
+
+	return result, nil
+}
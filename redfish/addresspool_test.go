@@ -0,0 +1,68 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var addressPoolBody = strings.NewReader(
+	`{
+		"@odata.context": "/redfish/v1/$metadata#AddressPool.AddressPool",
+		"@odata.type": "#AddressPool.v1_1_0.AddressPool",
+		"@odata.id": "/redfish/v1/Fabrics/NVMeoF/AddressPools/Pool1",
+		"Id": "Pool1",
+		"Name": "Host subnet",
+		"Ethernet": {
+			"IPv4": {
+				"GatewayIPAddress": "192.168.1.1",
+				"HostAddressRange": {
+					"Lower": "192.168.1.100",
+					"Upper": "192.168.1.200"
+				},
+				"VLANIdentifierAddressRange": {
+					"Lower": 100,
+					"Upper": 200
+				}
+			}
+		},
+		"Status": {
+			"State": "Enabled",
+			"Health": "OK"
+		},
+		"Links": {
+			"Zones": [
+				{"@odata.id": "/redfish/v1/Fabrics/NVMeoF/Zones/1"}
+			]
+		}
+	}`)
+
+// TestAddressPool tests the parsing of AddressPool objects.
+func TestAddressPool(t *testing.T) {
+	var result AddressPool
+	err := json.NewDecoder(addressPoolBody).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "Pool1" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.Ethernet.IPv4.HostAddressRange.Upper != "192.168.1.200" {
+		t.Errorf("Invalid host address range: %v", result.Ethernet.IPv4.HostAddressRange)
+	}
+
+	if result.Ethernet.IPv4.VLANIdentifierAddressRange.Lower != 100 {
+		t.Errorf("Invalid VLAN range: %v", result.Ethernet.IPv4.VLANIdentifierAddressRange)
+	}
+
+	if result.zones[0] != "/redfish/v1/Fabrics/NVMeoF/Zones/1" {
+		t.Errorf("Invalid zone links: %v", result.zones)
+	}
+}
@@ -0,0 +1,132 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// ChargeState is the charge state of a battery.
+type ChargeState string
+
+const (
+	// IdleChargeState The battery is idle.
+	IdleChargeState ChargeState = "Idle"
+	// ChargingChargeState The battery is charging.
+	ChargingChargeState ChargeState = "Charging"
+	// DischargingChargeState The battery is discharging.
+	DischargingChargeState ChargeState = "Discharging"
+)
+
+// Battery shall represent a battery of a PowerSubsystem.
+type Battery struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// CapacityActualAmpHours shall contain the actual maximum capacity of
+	// this battery in amp-hours.
+	CapacityActualAmpHours *float32
+	// CapacityActualWattHours shall contain the actual maximum capacity of
+	// this battery in watt-hours.
+	CapacityActualWattHours *float32
+	// CapacityRatedAmpHours shall contain the rated maximum capacity of this
+	// battery in amp-hours.
+	CapacityRatedAmpHours *float32
+	// CapacityRatedWattHours shall contain the rated maximum capacity of this
+	// battery in watt-hours.
+	CapacityRatedWattHours *float32
+	// ChargeState shall contain the charge state of this battery.
+	ChargeState ChargeState
+	// Description provides a description of this resource.
+	Description string
+	// FirmwareVersion shall contain the firmware version as defined by the
+	// manufacturer for this battery.
+	FirmwareVersion string
+	// HotPluggable shall indicate whether the device can be inserted or
+	// removed while the underlying equipment otherwise remains in its
+	// current operational state.
+	HotPluggable bool
+	// Location shall contain the location information of the battery.
+	Location common.Location
+	// LocationIndicatorActive shall contain the state of the indicator used
+	// to physically identify or locate this resource.
+	LocationIndicatorActive bool
+	// Manufacturer shall contain the name of the organization responsible
+	// for producing the battery.
+	Manufacturer string
+	// Model shall contain the model information as defined by the
+	// manufacturer for this battery.
+	Model string
+	// PartNumber shall contain the part number as defined by the
+	// manufacturer for this battery.
+	PartNumber string
+	// Replaceable shall indicate whether this component can be independently
+	// replaced.
+	Replaceable bool
+	// SerialNumber shall contain the serial number as defined by the
+	// manufacturer for this battery.
+	SerialNumber string
+	// SparePartNumber shall contain the spare or replacement part number as
+	// defined by the manufacturer for this battery.
+	SparePartNumber string
+	// StateOfHealthPercent shall contain the state of health, in percent,
+	// of this battery.
+	StateOfHealthPercent SensorExcerpt
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// Version shall contain the hardware version of this battery.
+	Version string
+}
+
+// GetBattery will get a Battery instance from the service.
+func GetBattery(c common.Client, uri string) (*Battery, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var battery Battery
+	err = json.NewDecoder(resp.Body).Decode(&battery)
+	if err != nil {
+		return nil, err
+	}
+
+	battery.SetClient(c)
+	return &battery, nil
+}
+
+// ListReferencedBatteries gets the collection of Battery from
+// a provided reference.
+func ListReferencedBatteries(c common.Client, link string) ([]*Battery, error) {
+	var result []*Battery
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, batteryLink := range links.ItemLinks {
+		battery, err := GetBattery(c, batteryLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, battery)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,195 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// CertificateType is the format of a certificate.
+type CertificateType string
+
+const (
+	// PEMCertificateType A Privacy Enhanced Mail (PEM)-encoded certificate.
+	PEMCertificateType CertificateType = "PEM"
+	// PKCS7CertificateType A Privacy Enhanced Mail (PEM)-encoded PKCS7
+	// certificate.
+	PKCS7CertificateType CertificateType = "PKCS7"
+)
+
+// KeyUsage is the usage of a key contained in a certificate.
+type KeyUsage string
+
+const (
+	// DigitalSignatureKeyUsage Verifies digital signatures, other than
+	// signatures on certificates and CRLs.
+	DigitalSignatureKeyUsage KeyUsage = "DigitalSignature"
+	// NonRepudiationKeyUsage Verifies digital signatures, other than
+	// signatures on certificates and CRLs, and provides a non-repudiation
+	// service that protects against the signing entity falsely denying
+	// some action.
+	NonRepudiationKeyUsage KeyUsage = "NonRepudiation"
+	// KeyEnciphermentKeyUsage Enciphers private or secret keys.
+	KeyEnciphermentKeyUsage KeyUsage = "KeyEncipherment"
+	// DataEnciphermentKeyUsage Directly enciphers raw user data without an
+	// intervening symmetric cipher.
+	DataEnciphermentKeyUsage KeyUsage = "DataEncipherment"
+	// KeyAgreementKeyUsage Key agreement.
+	KeyAgreementKeyUsage KeyUsage = "KeyAgreement"
+	// KeyCertSignKeyUsage Verifies signatures on public key certificates.
+	KeyCertSignKeyUsage KeyUsage = "KeyCertSign"
+	// CRLSigningKeyUsage Verifies signatures on certificate revocation lists
+	// (CRLs).
+	CRLSigningKeyUsage KeyUsage = "CRLSigning"
+	// EncipherOnlyKeyUsage Enciphers data while performing a key agreement.
+	EncipherOnlyKeyUsage KeyUsage = "EncipherOnly"
+	// DecipherOnlyKeyUsage Deciphers data while performing a key agreement.
+	DecipherOnlyKeyUsage KeyUsage = "DecipherOnly"
+	// ServerAuthenticationKeyUsage TLS WWW server authentication.
+	ServerAuthenticationKeyUsage KeyUsage = "ServerAuthentication"
+	// ClientAuthenticationKeyUsage TLS WWW client authentication.
+	ClientAuthenticationKeyUsage KeyUsage = "ClientAuthentication"
+	// CodeSigningKeyUsage Signs downloadable executable code.
+	CodeSigningKeyUsage KeyUsage = "CodeSigning"
+	// EmailProtectionKeyUsage Email protection.
+	EmailProtectionKeyUsage KeyUsage = "EmailProtection"
+	// TimestampingKeyUsage Binds the hash of an object to a time.
+	TimestampingKeyUsage KeyUsage = "Timestamping"
+	// OCSPSigningKeyUsage Signs OCSP responses.
+	OCSPSigningKeyUsage KeyUsage = "OCSPSigning"
+)
+
+// CertificateIdentifier shall contain the properties used to identify the
+// issuer or subject of a certificate.
+type CertificateIdentifier struct {
+	// City shall contain the city or locality of the organization of the
+	// entity.
+	City string
+	// CommonName shall contain the fully qualified domain name of the entity.
+	CommonName string
+	// Country shall contain the two-letter ISO code for the country of the
+	// organization of the entity.
+	Country string
+	// Email shall contain the email address of the contact within the
+	// organization of the entity.
+	Email string
+	// Organization shall contain the name of the organization of the entity.
+	Organization string
+	// OrganizationalUnit shall contain the name of the unit or division of
+	// the organization of the entity.
+	OrganizationalUnit string
+	// State shall contain the state, province, or region of the organization
+	// of the entity.
+	State string
+}
+
+// Certificate is used to represent a certificate installed on the service.
+type Certificate struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// CertificateString shall contain the string of the certificate, and
+	// the format shall follow the requirements specified by the
+	// CertificateType property value.
+	CertificateString string
+	// CertificateType shall contain the format type for the certificate.
+	CertificateType CertificateType
+	// Description provides a description of this resource.
+	Description string
+	// Fingerprint shall be a string containing the ASCII representation of
+	// the fingerprint of the certificate.
+	Fingerprint string
+	// FingerprintHashAlgorithm shall be a string containing the hash
+	// algorithm used for generating the Fingerprint property.
+	FingerprintHashAlgorithm string
+	// Issuer shall contain an object containing information about the
+	// issuer of the certificate.
+	Issuer CertificateIdentifier
+	// KeyUsage shall contain the key usage extension, which defines the
+	// purpose of the public keys in this certificate.
+	KeyUsage []KeyUsage
+	// SerialNumber shall be a string containing the ASCII representation of
+	// the serial number of the certificate.
+	SerialNumber string
+	// SignatureAlgorithm shall be a string containing the algorithm used for
+	// generating the signature of the certificate.
+	SignatureAlgorithm string
+	// Subject shall contain an object containing information about the
+	// subject of the certificate.
+	Subject CertificateIdentifier
+	// UefiSignatureOwner shall contain the GUID of the UEFI signature owner
+	// for this certificate as defined by the UEFI Specification. This
+	// property shall only be present if the certificate is within a UEFI
+	// signature database.
+	UefiSignatureOwner string
+	// ValidNotAfter shall contain the date when the certificate validity
+	// period ends.
+	ValidNotAfter string
+	// ValidNotBefore shall contain the date when the certificate validity
+	// period begins.
+	ValidNotBefore string
+}
+
+// GetCertificate will get a Certificate instance from the service.
+func GetCertificate(c common.Client, uri string) (*Certificate, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var certificate Certificate
+	err = json.NewDecoder(resp.Body).Decode(&certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate.SetClient(c)
+	return &certificate, nil
+}
+
+// ListReferencedCertificates gets the collection of Certificate from
+// a provided reference.
+func ListReferencedCertificates(c common.Client, link string) ([]*Certificate, error) {
+	var result []*Certificate
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, certificateLink := range links.ItemLinks {
+		certificate, err := GetCertificate(c, certificateLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, certificate)
+	}
+
+	return result, nil
+}
+
+// Delete removes the certificate from the service.
+func (certificate *Certificate) Delete() error {
+	resp, err := certificate.Client.Delete(certificate.ODataID)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
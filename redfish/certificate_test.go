@@ -0,0 +1,74 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var certificateBody = strings.NewReader(
+	`{
+		"@odata.context": "/redfish/v1/$metadata#Certificate.Certificate",
+		"@odata.type": "#Certificate.v1_2_0.Certificate",
+		"@odata.id": "/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates/1",
+		"Id": "1",
+		"Name": "HTTPS Certificate",
+		"CertificateString": "-----BEGIN CERTIFICATE-----\nMIIFsTCC [**truncated example**] GXG5zljlu\n-----END CERTIFICATE-----",
+		"CertificateType": "PEM",
+		"Issuer": {
+			"Country": "US",
+			"State": "Oregon",
+			"City": "Portland",
+			"Organization": "Contoso",
+			"OrganizationalUnit": "ABC",
+			"CommonName": "manager.contoso.org"
+		},
+		"Subject": {
+			"Country": "US",
+			"State": "Oregon",
+			"City": "Portland",
+			"Organization": "Contoso",
+			"OrganizationalUnit": "ABC",
+			"CommonName": "manager.contoso.org"
+		},
+		"ValidNotBefore": "2018-09-07T13:22:05Z",
+		"ValidNotAfter": "2019-09-07T13:22:05Z",
+		"KeyUsage": [
+			"KeyEncipherment",
+			"ServerAuthentication"
+		]
+	}`)
+
+// TestCertificate tests the parsing of Certificate objects.
+func TestCertificate(t *testing.T) {
+	var result Certificate
+	err := json.NewDecoder(certificateBody).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "1" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.CertificateType != PEMCertificateType {
+		t.Errorf("Invalid certificate type: %s", result.CertificateType)
+	}
+
+	if result.Issuer.CommonName != "manager.contoso.org" {
+		t.Errorf("Invalid issuer common name: %s", result.Issuer.CommonName)
+	}
+
+	if result.ValidNotAfter != "2019-09-07T13:22:05Z" {
+		t.Errorf("Invalid ValidNotAfter: %s", result.ValidNotAfter)
+	}
+
+	if len(result.KeyUsage) != 2 || result.KeyUsage[1] != ServerAuthenticationKeyUsage {
+		t.Errorf("Invalid key usage: %v", result.KeyUsage)
+	}
+}
@@ -0,0 +1,238 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// CertificateService is used to represent the certificate service
+// properties for a Redfish implementation.
+type CertificateService struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// certificateLocations shall contain a link to a resource of type
+	// CertificateLocations.
+	certificateLocations string
+	// Description provides a description of this resource.
+	Description string
+	// generateCSRTarget is the URL to send GenerateCSR requests.
+	generateCSRTarget string
+	// replaceCertificateTarget is the URL to send ReplaceCertificate
+	// requests.
+	replaceCertificateTarget string
+}
+
+// UnmarshalJSON unmarshals a CertificateService object from the raw JSON.
+func (certificateservice *CertificateService) UnmarshalJSON(b []byte) error {
+	type temp CertificateService
+	type actions struct {
+		GenerateCSR struct {
+			Target string
+		} `json:"#CertificateService.GenerateCSR"`
+		ReplaceCertificate struct {
+			Target string
+		} `json:"#CertificateService.ReplaceCertificate"`
+	}
+	var t struct {
+		temp
+		Actions              actions
+		CertificateLocations common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*certificateservice = CertificateService(t.temp)
+
+	// Extract the links to other entities for later
+	certificateservice.certificateLocations = string(t.CertificateLocations)
+	certificateservice.generateCSRTarget = t.Actions.GenerateCSR.Target
+	certificateservice.replaceCertificateTarget = t.Actions.ReplaceCertificate.Target
+
+	return nil
+}
+
+// GetCertificateService will get a CertificateService instance from the service.
+func GetCertificateService(c common.Client, uri string) (*CertificateService, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var certificateservice CertificateService
+	err = json.NewDecoder(resp.Body).Decode(&certificateservice)
+	if err != nil {
+		return nil, err
+	}
+
+	certificateservice.SetClient(c)
+	return &certificateservice, nil
+}
+
+// CertificateLocations gets all certificates installed on the service.
+func (certificateservice *CertificateService) CertificateLocations() ([]*Certificate, error) {
+	var result []*Certificate
+	if certificateservice.certificateLocations == "" {
+		return result, nil
+	}
+
+	resp, err := certificateservice.Client.Get(certificateservice.certificateLocations)
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+
+	var t struct {
+		Links struct {
+			Certificates common.Links
+		}
+	}
+	err = json.NewDecoder(resp.Body).Decode(&t)
+	if err != nil {
+		return result, err
+	}
+
+	for _, certificateLink := range t.Links.Certificates.ToStrings() {
+		certificate, err := GetCertificate(certificateservice.Client, certificateLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, certificate)
+	}
+
+	return result, nil
+}
+
+// GenerateCSRRequest contains the parameters for generating a certificate
+// signing request.
+type GenerateCSRRequest struct {
+	// AlternativeNames shall contain an array of additional host names of
+	// the component to secure.
+	AlternativeNames []string `json:",omitempty"`
+	// CertificateCollection shall contain the URI of the certificate
+	// collection where the certificate is installed once it is signed.
+	CertificateCollection common.Link
+	// ChallengePassword shall contain the challenge password to apply to the
+	// certificate for revocation requests.
+	ChallengePassword string `json:",omitempty"`
+	// City shall contain the city or locality of the organization making the
+	// request.
+	City string
+	// CommonName shall contain the fully qualified domain name of the
+	// component to secure.
+	CommonName string
+	// ContactPerson shall contain the name of the user making the request.
+	ContactPerson string `json:",omitempty"`
+	// Country shall contain the two-letter ISO code for the country of the
+	// organization making the request.
+	Country string
+	// Email shall contain the email address of the contact within the
+	// organization making the request.
+	Email string `json:",omitempty"`
+	// GivenName shall contain the given name of the user making the request.
+	GivenName string `json:",omitempty"`
+	// Initials shall contain the initials of the user making the request.
+	Initials string `json:",omitempty"`
+	// KeyBitLength shall contain the length of the key, in bits, if needed
+	// based on the KeyPairAlgorithm parameter value.
+	KeyBitLength int `json:",omitempty"`
+	// KeyCurveID shall contain the curve ID to use with the key, if needed
+	// based on the KeyPairAlgorithm parameter value.
+	KeyCurveID string `json:"KeyCurveId,omitempty"`
+	// KeyPairAlgorithm shall contain the type of key-pair for use with
+	// signing algorithms, such as TPM_ALG_RSA or TPM_ALG_ECDSA.
+	KeyPairAlgorithm string `json:",omitempty"`
+	// KeyUsage shall contain the usage of the key contained in the
+	// certificate.
+	KeyUsage []KeyUsage `json:",omitempty"`
+	// Organization shall contain the name of the organization making the
+	// request.
+	Organization string
+	// OrganizationalUnit shall contain the name of the unit or division of
+	// the organization making the request.
+	OrganizationalUnit string
+	// State shall contain the state, province, or region of the organization
+	// making the request.
+	State string
+	// Surname shall contain the surname of the user making the request.
+	Surname string `json:",omitempty"`
+	// UnstructuredName shall contain the unstructured name of the subject.
+	UnstructuredName string `json:",omitempty"`
+}
+
+// GenerateCSRResponse is the result of a GenerateCSR request.
+type GenerateCSRResponse struct {
+	// CSRString shall contain the Privacy Enhanced Mail (PEM)-encoded string,
+	// which contains RFC2986-specified structures, of the certificate
+	// signing request.
+	CSRString string
+	// CertificateCollection shall contain a link to the certificate
+	// collection where the certificate is installed.
+	CertificateCollection common.Link
+}
+
+// GenerateCSR generates a certificate signing request on the service. The
+// returned CSR is signed by a certificate authority and the result installed
+// with ReplaceCertificate or by adding it to the certificate collection.
+func (certificateservice *CertificateService) GenerateCSR(request *GenerateCSRRequest) (*GenerateCSRResponse, error) {
+	if certificateservice.generateCSRTarget == "" {
+		return nil, fmt.Errorf("GenerateCSR is not supported by this service")
+	}
+
+	resp, err := certificateservice.Post(certificateservice.generateCSRTarget, request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result GenerateCSRResponse
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ReplaceCertificate replaces the certificate at the given URI with a new
+// certificate.
+func (certificateservice *CertificateService) ReplaceCertificate(certificateURI string, certificateString string, certificateType CertificateType) error {
+	if certificateservice.replaceCertificateTarget == "" {
+		return fmt.Errorf("ReplaceCertificate is not supported by this service")
+	}
+
+	t := struct {
+		CertificateString string
+		CertificateType   CertificateType
+		CertificateURI    common.Link `json:"CertificateUri"`
+	}{
+		CertificateString: certificateString,
+		CertificateType:   certificateType,
+		CertificateURI:    common.Link(certificateURI),
+	}
+
+	resp, err := certificateservice.Post(certificateservice.replaceCertificateTarget, t)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
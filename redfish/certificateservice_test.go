@@ -0,0 +1,107 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var certificateServiceBody = `{
+		"@odata.context": "/redfish/v1/$metadata#CertificateService.CertificateService",
+		"@odata.type": "#CertificateService.v1_0_0.CertificateService",
+		"@odata.id": "/redfish/v1/CertificateService",
+		"Id": "CertificateService",
+		"Name": "Certificate Service",
+		"Actions": {
+			"#CertificateService.GenerateCSR": {
+				"target": "/redfish/v1/CertificateService/Actions/CertificateService.GenerateCSR"
+			},
+			"#CertificateService.ReplaceCertificate": {
+				"target": "/redfish/v1/CertificateService/Actions/CertificateService.ReplaceCertificate",
+				"CertificateType@Redfish.AllowableValues": [
+					"PEM"
+				]
+			}
+		},
+		"CertificateLocations": {
+			"@odata.id": "/redfish/v1/CertificateService/CertificateLocations"
+		}
+	}`
+
+// TestCertificateService tests the parsing of CertificateService objects.
+func TestCertificateService(t *testing.T) {
+	var result CertificateService
+	err := json.NewDecoder(strings.NewReader(certificateServiceBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "CertificateService" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.certificateLocations != "/redfish/v1/CertificateService/CertificateLocations" {
+		t.Errorf("Invalid certificate locations link: %s", result.certificateLocations)
+	}
+
+	if result.generateCSRTarget != "/redfish/v1/CertificateService/Actions/CertificateService.GenerateCSR" {
+		t.Errorf("Invalid GenerateCSR target: %s", result.generateCSRTarget)
+	}
+
+	if result.replaceCertificateTarget != "/redfish/v1/CertificateService/Actions/CertificateService.ReplaceCertificate" {
+		t.Errorf("Invalid ReplaceCertificate target: %s", result.replaceCertificateTarget)
+	}
+}
+
+// TestCertificateServiceActions tests the GenerateCSR and
+// ReplaceCertificate actions.
+func TestCertificateServiceActions(t *testing.T) {
+	var result CertificateService
+	err := json.NewDecoder(strings.NewReader(certificateServiceBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{
+		responses: map[string]string{
+			"POST /redfish/v1/CertificateService/Actions/CertificateService.GenerateCSR": `{
+				"CSRString": "-----BEGIN CERTIFICATE REQUEST-----...",
+				"CertificateCollection": {
+					"@odata.id": "/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates"
+				}
+			}`,
+		},
+	}
+	result.SetClient(client)
+
+	csr, err := result.GenerateCSR(&GenerateCSRRequest{
+		CertificateCollection: "/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates",
+		CommonName:            "manager.contoso.org",
+		Country:               "US",
+	})
+	if err != nil {
+		t.Errorf("Error generating CSR: %s", err)
+	}
+
+	if csr.CSRString != "-----BEGIN CERTIFICATE REQUEST-----..." {
+		t.Errorf("Invalid CSR: %s", csr.CSRString)
+	}
+
+	if !strings.Contains(client.calls[0].Payload, `"CertificateCollection":{"@odata.id":"/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates"}`) {
+		t.Errorf("Unexpected GenerateCSR payload: %s", client.calls[0].Payload)
+	}
+
+	err = result.ReplaceCertificate("/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates/1", "-----BEGIN CERTIFICATE-----...", PEMCertificateType)
+	if err != nil {
+		t.Errorf("Error replacing certificate: %s", err)
+	}
+
+	if !strings.Contains(client.calls[1].Payload, `"CertificateUri":{"@odata.id":"/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates/1"}`) {
+		t.Errorf("Unexpected ReplaceCertificate payload: %s", client.calls[1].Payload)
+	}
+}
@@ -6,6 +6,8 @@ package redfish
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
 
 	"github.com/rocksolidlabs/gofish/common"
 )
@@ -79,21 +81,62 @@ const (
 // indirectly through this resource.
 type Chassis struct {
 	common.Entity
-	ChassisType     ChassisType   `json:"ChassisType"`
-	Manufacturer    string        `json:"Manufacturer"`
-	Model           string        `json:"Model"`
-	SKU             string        `json:"SKU"`
-	SerialNumber    string        `json:"SerialNumber"`
-	Version         string        `json:"Version"`
-	PartNumber      string        `json:"PartNumber"`
-	AssetTag        string        `json:"AssetTag"`
-	Status          common.Status `json:"Status"`
-	thermal         string
-	power           string
-	networkAdapters string
-	computerSystems []string
-	resourceBlocks  []string
-	managedBy       []string
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+
+	ChassisType      ChassisType   `json:"ChassisType"`
+	Manufacturer     string        `json:"Manufacturer"`
+	Model            string        `json:"Model"`
+	SKU              string        `json:"SKU"`
+	SerialNumber     string        `json:"SerialNumber"`
+	Version          string        `json:"Version"`
+	PartNumber       string        `json:"PartNumber"`
+	AssetTag         string        `json:"AssetTag"`
+	Status           common.Status `json:"Status"`
+	thermal          string
+	power            string
+	thermalSubsystem string
+	powerSubsystem   string
+	sensors          string
+	networkAdapters  string
+	computerSystems  []string
+	resourceBlocks   []string
+	managedBy        []string
+	contains         []string
+	containedBy      string
+	drives           []string
+	storage          []string
+	pcieDevices      []string
+	pcieDevicesLink  string
+	poweredBy        []string
+	cooledBy         []string
+
+	// HeightMm shall represent the height of the chassis, in millimeters, as
+	// specified by the manufacturer.
+	HeightMm *float32
+	// IndicatorLED shall contain the indicator light state for the
+	// indicator light associated with this chassis.
+	IndicatorLED common.IndicatorLED
+	// Location shall contain location information of the chassis, such as
+	// its rack and offset within the rack.
+	Location common.Location
+	// LocationIndicatorActive shall contain the state of the indicator used
+	// to physically identify or locate this chassis. It replaces
+	// IndicatorLED on newer services.
+	LocationIndicatorActive bool
+	// SupportedResetTypes, if provided, is the reset types this chassis
+	// supports.
+	SupportedResetTypes []ResetType
+	// rawData holds the original serialized JSON so we can compare updates.
+	rawData     []byte
+	resetTarget string
 }
 
 // UnmarshalJSON unmarshals a Chassis object from the raw JSON.
@@ -103,13 +146,31 @@ func (c *Chassis) UnmarshalJSON(b []byte) error {
 		ComputerSystems common.Links
 		ResourceBlocks  common.Links
 		ManagedBy       common.Links
+		Contains        common.Links
+		ContainedBy     common.Link
+		Drives          common.Links
+		Storage         common.Links
+		PCIeDevices     common.Links
+		PoweredBy       common.Links
+		CooledBy        common.Links
+	}
+	type actions struct {
+		ChassisReset struct {
+			AllowableValues []ResetType `json:"ResetType@Redfish.AllowableValues"`
+			Target          string
+		} `json:"#Chassis.Reset"`
 	}
 	var t struct {
 		temp
-		Thermal         common.Link
-		Power           common.Link
-		NetworkAdapters common.Link
-		Links           linkReference
+		Actions          actions
+		Thermal          common.Link
+		Power            common.Link
+		ThermalSubsystem common.Link
+		PowerSubsystem   common.Link
+		Sensors          common.Link
+		PCIeDevices      common.Link
+		NetworkAdapters  common.Link
+		Links            linkReference
 	}
 
 	err := json.Unmarshal(b, &t)
@@ -122,10 +183,74 @@ func (c *Chassis) UnmarshalJSON(b []byte) error {
 	// Extract the links to other entities for later
 	c.thermal = string(t.Thermal)
 	c.power = string(t.Power)
+	c.thermalSubsystem = string(t.ThermalSubsystem)
+	c.powerSubsystem = string(t.PowerSubsystem)
+	c.sensors = string(t.Sensors)
 	c.networkAdapters = string(t.NetworkAdapters)
 	c.computerSystems = t.Links.ComputerSystems.ToStrings()
 	c.resourceBlocks = t.Links.ResourceBlocks.ToStrings()
 	c.managedBy = t.Links.ManagedBy.ToStrings()
+	c.contains = t.Links.Contains.ToStrings()
+	c.containedBy = string(t.Links.ContainedBy)
+	c.drives = t.Links.Drives.ToStrings()
+	c.storage = t.Links.Storage.ToStrings()
+	c.pcieDevices = t.Links.PCIeDevices.ToStrings()
+	c.pcieDevicesLink = string(t.PCIeDevices)
+	c.poweredBy = t.Links.PoweredBy.ToStrings()
+	c.cooledBy = t.Links.CooledBy.ToStrings()
+	c.resetTarget = t.Actions.ChassisReset.Target
+	c.SupportedResetTypes = t.Actions.ChassisReset.AllowableValues
+
+	// This is a read/write object, so we need to save the raw object data for later
+	c.rawData = b
+
+	return nil
+}
+
+// Update commits updates to this object's properties to the running system.
+// Only the properties that were changed are sent to the service.
+func (c *Chassis) Update() error {
+	// Get a representation of the object's original state so we can find what
+	// to update.
+	original := new(Chassis)
+	err := original.UnmarshalJSON(c.rawData)
+	if err != nil {
+		return err
+	}
+
+	readWriteFields := []string{
+		"AssetTag",
+		"IndicatorLED",
+		"LocationIndicatorActive",
+	}
+
+	originalElement := reflect.ValueOf(original).Elem()
+	currentElement := reflect.ValueOf(c).Elem()
+
+	return c.Entity.Update(c.ODataID, originalElement, currentElement, readWriteFields, &c.rawData)
+}
+
+// Reset resets the chassis, such as power cycling it. The reset type is
+// checked against the types the service allows, if it lists them.
+func (c *Chassis) Reset(resetType ResetType) error {
+	if c.resetTarget == "" {
+		return fmt.Errorf("Reset is not supported by this service")
+	}
+
+	err := allowableValue(resetType, c.SupportedResetTypes, "reset type", "chassis")
+	if err != nil {
+		return err
+	}
+
+	t := struct {
+		ResetType ResetType
+	}{ResetType: resetType}
+
+	resp, err := c.Post(c.resetTarget, t)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
 
 	return nil
 }
@@ -173,19 +298,7 @@ func (c *Chassis) Thermal() (*Thermal, error) {
 		return nil, nil
 	}
 
-	resp, err := c.Client.Get(c.thermal)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	var thermal Thermal
-	err = json.NewDecoder(resp.Body).Decode(&thermal)
-	if err != nil {
-		return nil, err
-	}
-
-	return &thermal, nil
+	return GetThermal(c.Client, c.thermal)
 }
 
 // Power gets the power information for the chassis
@@ -194,19 +307,32 @@ func (c *Chassis) Power() (*Power, error) {
 		return nil, nil
 	}
 
-	resp, err := c.Client.Get(c.power)
-	if err != nil {
-		return nil, err
+	return GetPower(c.Client, c.power)
+}
+
+// ThermalSubsystem gets the thermal subsystem of the chassis, which replaces
+// the deprecated Thermal resource on newer services.
+func (c *Chassis) ThermalSubsystem() (*ThermalSubsystem, error) {
+	if c.thermalSubsystem == "" {
+		return nil, nil
 	}
-	defer resp.Body.Close()
 
-	var power Power
-	err = json.NewDecoder(resp.Body).Decode(&power)
-	if err != nil {
-		return nil, err
+	return GetThermalSubsystem(c.Client, c.thermalSubsystem)
+}
+
+// PowerSubsystem gets the power subsystem of the chassis, which replaces the
+// deprecated Power resource on newer services.
+func (c *Chassis) PowerSubsystem() (*PowerSubsystem, error) {
+	if c.powerSubsystem == "" {
+		return nil, nil
 	}
 
-	return &power, nil
+	return GetPowerSubsystem(c.Client, c.powerSubsystem)
+}
+
+// Sensors gets the sensors of the chassis.
+func (c *Chassis) Sensors() ([]*Sensor, error) {
+	return ListReferencedSensors(c.Client, c.sensors)
 }
 
 // ComputerSystems returns the collection of systems from this chassis
@@ -239,6 +365,112 @@ func (c *Chassis) ManagedBy() ([]*Manager, error) {
 	return result, nil
 }
 
+// Contains gets the chassis contained in this chassis, such as the
+// enclosures in a rack.
+func (c *Chassis) Contains() ([]*Chassis, error) {
+	var result []*Chassis
+	for _, uri := range c.contains {
+		chassis, err := GetChassis(c.Client, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, chassis)
+	}
+
+	return result, nil
+}
+
+// ContainedBy gets the chassis that contains this chassis, such as the rack
+// of an enclosure. It returns nil if the chassis is not contained.
+func (c *Chassis) ContainedBy() (*Chassis, error) {
+	if c.containedBy == "" {
+		return nil, nil
+	}
+
+	return GetChassis(c.Client, c.containedBy)
+}
+
+// Drives gets the drives in this chassis.
+func (c *Chassis) Drives() ([]*Drive, error) {
+	var result []*Drive
+	for _, uri := range c.drives {
+		drive, err := GetDrive(c.Client, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, drive)
+	}
+
+	return result, nil
+}
+
+// Storage gets the storage subsystems in this chassis.
+func (c *Chassis) Storage() ([]*Storage, error) {
+	var result []*Storage
+	for _, uri := range c.storage {
+		storage, err := GetStorage(c.Client, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, storage)
+	}
+
+	return result, nil
+}
+
+// PCIeDevices gets the PCIe devices in this chassis, from its PCIeDevices
+// collection if it has one, otherwise from its links.
+func (c *Chassis) PCIeDevices() ([]*PCIeDevice, error) {
+	if c.pcieDevicesLink != "" {
+		return ListReferencedPCIeDevices(c.Client, c.pcieDevicesLink)
+	}
+
+	var result []*PCIeDevice
+	for _, uri := range c.pcieDevices {
+		device, err := GetPCIeDevice(c.Client, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, device)
+	}
+
+	return result, nil
+}
+
+// PoweredBy gets the resources that power this chassis, such as power
+// supplies. The links may point into a resource, such as
+// "/redfish/v1/Chassis/1/Power#/PowerSupplies/0", which is kept in the
+// Fragment of the Resource.
+func (c *Chassis) PoweredBy() ([]*Resource, error) {
+	return getResources(c.Client, c.poweredBy)
+}
+
+// CooledBy gets the resources that cool this chassis, such as fans. The
+// links may point into a resource, which is kept in the Fragment of the
+// Resource.
+func (c *Chassis) CooledBy() ([]*Resource, error) {
+	return getResources(c.Client, c.cooledBy)
+}
+
+// getResources gets the resources of any type at the uris.
+func getResources(c common.Client, uris []string) ([]*Resource, error) {
+	var result []*Resource
+	for _, uri := range uris {
+		resource, err := GetResource(c, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, resource)
+	}
+
+	return result, nil
+}
+
 // NetworkAdapters gets the collection of network adapters of this chassis
 func (c *Chassis) NetworkAdapters() ([]*NetworkAdapter, error) {
 	return ListReferencedNetworkAdapter(c.Client, c.networkAdapters)
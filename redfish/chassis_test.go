@@ -48,6 +48,11 @@ var chassisBody = strings.NewReader(
 				{
 					"@odata.id": "/redfish/v1/Managers/BMC-1"
 				}
+			],
+			"Contains": [
+				{
+					"@odata.id": "/redfish/v1/Chassis/Blade-1"
+				}
 			]
 		}
 	}`)
@@ -69,6 +74,10 @@ func TestChassis(t *testing.T) {
 		t.Errorf("Received invalid name: %s", result.Name)
 	}
 
+	if len(result.contains) != 1 || result.contains[0] != "/redfish/v1/Chassis/Blade-1" {
+		t.Errorf("Received invalid contained chassis: %v", result.contains)
+	}
+
 	if result.AssetTag != "Chicago-45Z-2381" {
 		t.Errorf("Received invalid asset tag: %s", result.AssetTag)
 	}
@@ -109,3 +118,177 @@ func TestChassis(t *testing.T) {
 		t.Errorf("Invalid managed by reference: %s", result.managedBy[0])
 	}
 }
+
+// TestChassisUpdate tests that only changed properties are patched.
+func TestChassisUpdate(t *testing.T) {
+	var result Chassis
+	err := json.NewDecoder(strings.NewReader(`{
+		"@odata.id": "/redfish/v1/Chassis/Chassis-1",
+		"Id": "Chassis-1",
+		"AssetTag": "Chicago-45Z-2381",
+		"IndicatorLED": "Off",
+		"LocationIndicatorActive": false
+	}`)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+	if len(client.calls) != 0 {
+		t.Errorf("Unchanged chassis should not be patched: %v", client.calls)
+	}
+
+	result.AssetTag = "Rack-12-U30"
+	result.IndicatorLED = common.BlinkingIndicatorLED
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+
+	expected := `{"AssetTag":"Rack-12-U30","IndicatorLED":"Blinking"}`
+	if len(client.calls) != 1 || client.calls[0].Method != "PATCH" ||
+		client.calls[0].URL != "/redfish/v1/Chassis/Chassis-1" || client.calls[0].Payload != expected {
+		t.Errorf("Unexpected update request: %v", client.calls)
+	}
+
+	// Setting the LED back is compared against the updated state.
+	result.IndicatorLED = common.OffIndicatorLED
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+
+	if len(client.calls) != 2 || client.calls[1].Payload != `{"IndicatorLED":"Off"}` {
+		t.Errorf("Unexpected second update request: %v", client.calls)
+	}
+
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+	if len(client.calls) != 2 {
+		t.Errorf("Unchanged chassis should not be patched again: %v", client.calls)
+	}
+}
+
+// TestChassisReset tests resetting a chassis with a supported reset type.
+func TestChassisReset(t *testing.T) {
+	var result Chassis
+	err := json.NewDecoder(strings.NewReader(`{
+		"@odata.id": "/redfish/v1/Chassis/Chassis-1",
+		"Actions": {
+			"#Chassis.Reset": {
+				"target": "/redfish/v1/Chassis/Chassis-1/Actions/Chassis.Reset",
+				"ResetType@Redfish.AllowableValues": ["On", "ForceOff", "PowerCycle"]
+			}
+		}
+	}`)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	err = result.Reset(GracefulRestartResetType)
+	if err == nil || err.Error() != "reset type GracefulRestart is not supported by this chassis, expected one of [On ForceOff PowerCycle]" {
+		t.Errorf("Expected error for a reset type the chassis does not allow, got: %v", err)
+	}
+
+	err = result.Reset(PowerCycleResetType)
+	if err != nil {
+		t.Errorf("Error making Reset call: %s", err)
+	}
+
+	if len(client.calls) != 1 ||
+		client.calls[0].URL != "/redfish/v1/Chassis/Chassis-1/Actions/Chassis.Reset" ||
+		client.calls[0].Payload != `{"ResetType":"PowerCycle"}` {
+		t.Errorf("Unexpected reset request: %v", client.calls)
+	}
+
+	var unsupported Chassis
+	err = json.Unmarshal([]byte(`{"@odata.id": "/redfish/v1/Chassis/Chassis-2"}`), &unsupported)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+	if unsupported.Reset(OnResetType) == nil {
+		t.Error("Expected error for a chassis without the reset action")
+	}
+}
+
+// TestChassisTopology tests navigating the links of a chassis.
+func TestChassisTopology(t *testing.T) {
+	client := &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/Chassis/Rack":        `{"@odata.id": "/redfish/v1/Chassis/Rack", "ChassisType": "Rack"}`,
+			"GET /redfish/v1/Chassis/1/Drives/0":  `{"@odata.id": "/redfish/v1/Chassis/1/Drives/0", "Id": "0"}`,
+			"GET /redfish/v1/Systems/1/Storage/1": `{"@odata.id": "/redfish/v1/Systems/1/Storage/1", "Id": "1"}`,
+			"GET /redfish/v1/Chassis/1/PCIeDevices": `{
+				"Members": [{"@odata.id": "/redfish/v1/Chassis/1/PCIeDevices/NIC"}],
+				"Members@odata.count": 1
+			}`,
+			"GET /redfish/v1/Chassis/1/PCIeDevices/NIC": `{"@odata.id": "/redfish/v1/Chassis/1/PCIeDevices/NIC", "Id": "NIC"}`,
+			"GET /redfish/v1/Chassis/1/Power": `{
+				"@odata.id": "/redfish/v1/Chassis/1/Power",
+				"@odata.type": "#Power.v1_5_0.Power"
+			}`,
+		},
+	}
+
+	var result Chassis
+	err := json.NewDecoder(strings.NewReader(`{
+		"@odata.id": "/redfish/v1/Chassis/1",
+		"PCIeDevices": {"@odata.id": "/redfish/v1/Chassis/1/PCIeDevices"},
+		"Links": {
+			"ContainedBy": {"@odata.id": "/redfish/v1/Chassis/Rack"},
+			"Drives": [{"@odata.id": "/redfish/v1/Chassis/1/Drives/0"}],
+			"Storage": [{"@odata.id": "/redfish/v1/Systems/1/Storage/1"}],
+			"PCIeDevices": [{"@odata.id": "/redfish/v1/Chassis/1/PCIeDevices/Old"}],
+			"PoweredBy": [{"@odata.id": "/redfish/v1/Chassis/1/Power#/PowerSupplies/0"}],
+			"CooledBy": []
+		}
+	}`)).Decode(&result)
+	if err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+	result.SetClient(client)
+
+	rack, err := result.ContainedBy()
+	if err != nil || rack.ChassisType != RackChassisType {
+		t.Errorf("Unexpected container: %v %v", rack, err)
+	}
+
+	drives, err := result.Drives()
+	if err != nil || len(drives) != 1 || drives[0].ID != "0" {
+		t.Errorf("Unexpected drives: %v %v", drives, err)
+	}
+
+	storage, err := result.Storage()
+	if err != nil || len(storage) != 1 || storage[0].ID != "1" {
+		t.Errorf("Unexpected storage: %v %v", storage, err)
+	}
+
+	devices, err := result.PCIeDevices()
+	if err != nil || len(devices) != 1 || devices[0].ID != "NIC" {
+		t.Errorf("PCIe devices should come from the collection: %v %v", devices, err)
+	}
+
+	poweredBy, err := result.PoweredBy()
+	if err != nil || len(poweredBy) != 1 {
+		t.Fatalf("Unexpected powered by: %v %v", poweredBy, err)
+	}
+	if poweredBy[0].ResourceType() != "Power" || poweredBy[0].Fragment != "/PowerSupplies/0" {
+		t.Errorf("Unexpected power supply reference: %s %s", poweredBy[0].ResourceType(), poweredBy[0].Fragment)
+	}
+
+	cooledBy, err := result.CooledBy()
+	if err != nil || len(cooledBy) != 0 {
+		t.Errorf("Unexpected cooled by: %v %v", cooledBy, err)
+	}
+}
@@ -0,0 +1,97 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+// ChassisNode is a chassis in the physical containment hierarchy, such as
+// Row, Rack, Chassis, Blade and Card.
+type ChassisNode struct {
+	// Chassis is the chassis at this level of the hierarchy.
+	Chassis *Chassis
+	// Children are the chassis directly contained in this chassis, in the
+	// order they were given.
+	Children []*ChassisNode
+}
+
+// Walk calls fn for this node and all the nodes below it, parents before
+// their children. The depth of this node is zero.
+func (node *ChassisNode) Walk(fn func(node *ChassisNode, depth int)) {
+	node.walk(fn, 0)
+}
+
+// walk calls fn for the node and its children at the given depth.
+func (node *ChassisNode) walk(fn func(node *ChassisNode, depth int), depth int) {
+	fn(node, depth)
+	for _, child := range node.Children {
+		child.walk(fn, depth+1)
+	}
+}
+
+// BuildChassisTree arranges the chassis, such as the members of the Chassis
+// collection, into their containment hierarchy. The parent of a chassis is
+// the chassis it is ContainedBy, or else the chassis that Contains it. The
+// roots are the chassis without a parent among the given chassis, in the
+// order they were given. Containment loops reported by a service are broken
+// so each chassis appears exactly once.
+func BuildChassisTree(chassis []*Chassis) []*ChassisNode {
+	nodes := make(map[string]*ChassisNode, len(chassis))
+	for _, c := range chassis {
+		nodes[c.ODataID] = &ChassisNode{Chassis: c}
+	}
+
+	parents := make(map[string]string, len(chassis))
+	for _, c := range chassis {
+		for _, contained := range c.contains {
+			if _, ok := nodes[contained]; ok && contained != c.ODataID {
+				parents[contained] = c.ODataID
+			}
+		}
+	}
+	for _, c := range chassis {
+		if _, ok := nodes[c.containedBy]; ok && c.containedBy != c.ODataID {
+			parents[c.ODataID] = c.containedBy
+		}
+	}
+
+	children := make(map[string][]*ChassisNode, len(chassis))
+	var roots []*ChassisNode
+	for _, c := range chassis {
+		node := nodes[c.ODataID]
+		if parent, ok := parents[c.ODataID]; ok {
+			children[parent] = append(children[parent], node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	// Attach the children from the roots down, so chassis in a loop are
+	// never reached.
+	attached := make(map[string]bool, len(chassis))
+	var attach func(node *ChassisNode)
+	attach = func(node *ChassisNode) {
+		attached[node.Chassis.ODataID] = true
+		for _, child := range children[node.Chassis.ODataID] {
+			if attached[child.Chassis.ODataID] {
+				continue
+			}
+			node.Children = append(node.Children, child)
+			attach(child)
+		}
+	}
+	for _, root := range roots {
+		attach(root)
+	}
+
+	// Chassis in a loop become roots where the loop is first entered.
+	for _, c := range chassis {
+		if attached[c.ODataID] {
+			continue
+		}
+		node := nodes[c.ODataID]
+		roots = append(roots, node)
+		attach(node)
+	}
+
+	return roots
+}
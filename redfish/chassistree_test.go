@@ -0,0 +1,92 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// treeChassis decodes a chassis with the given containment links.
+func treeChassis(t *testing.T, id, containedBy string, contains ...string) *Chassis {
+	links := make([]string, len(contains))
+	for i, contained := range contains {
+		links[i] = fmt.Sprintf(`{"@odata.id": "/redfish/v1/Chassis/%s"}`, contained)
+	}
+
+	parent := ""
+	if containedBy != "" {
+		parent = fmt.Sprintf(`, "ContainedBy": {"@odata.id": "/redfish/v1/Chassis/%s"}`, containedBy)
+	}
+
+	var chassis Chassis
+	err := json.Unmarshal([]byte(fmt.Sprintf(`{
+		"@odata.id": "/redfish/v1/Chassis/%s",
+		"Id": "%s",
+		"Links": {"Contains": [%s]%s}
+	}`, id, id, strings.Join(links, ","), parent)), &chassis)
+	if err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+	return &chassis
+}
+
+// treeString renders the tree with one chassis per line, indented by depth.
+func treeString(roots []*ChassisNode) string {
+	var lines []string
+	for _, root := range roots {
+		root.Walk(func(node *ChassisNode, depth int) {
+			lines = append(lines, strings.Repeat("  ", depth)+node.Chassis.ID)
+		})
+	}
+	return strings.Join(lines, "\n")
+}
+
+// TestBuildChassisTree tests arranging chassis by Contains and ContainedBy
+// links.
+func TestBuildChassisTree(t *testing.T) {
+	chassis := []*Chassis{
+		treeChassis(t, "Blade1", "Enclosure"),
+		treeChassis(t, "Rack", "Row", "Enclosure"),
+		treeChassis(t, "Row", "", "Rack"),
+		treeChassis(t, "Card1", ""),
+		treeChassis(t, "Enclosure", "", "Blade1", "Blade2"),
+		treeChassis(t, "Blade2", "", "Card1"),
+		treeChassis(t, "Standalone", ""),
+	}
+
+	expected := strings.Join([]string{
+		"Row",
+		"  Rack",
+		"    Enclosure",
+		"      Blade1",
+		"      Blade2",
+		"        Card1",
+		"Standalone",
+	}, "\n")
+	if result := treeString(BuildChassisTree(chassis)); result != expected {
+		t.Errorf("Unexpected tree:\n%s\nexpected:\n%s", result, expected)
+	}
+}
+
+// TestBuildChassisTreeLoop tests that containment loops are broken.
+func TestBuildChassisTreeLoop(t *testing.T) {
+	chassis := []*Chassis{
+		treeChassis(t, "A", "", "B"),
+		treeChassis(t, "B", "", "A"),
+		treeChassis(t, "C", "C"),
+	}
+
+	expected := strings.Join([]string{
+		"C",
+		"A",
+		"  B",
+	}, "\n")
+	if result := treeString(BuildChassisTree(chassis)); result != expected {
+		t.Errorf("Unexpected tree:\n%s\nexpected:\n%s", result, expected)
+	}
+}
@@ -105,3 +105,15 @@ func ListReferencedCompositionServices(c common.Client, link string) ([]*Composi
 
 	return result, nil
 }
+
+// ResourceBlocks gets the resource blocks available for composition.
+func (cs *CompositionService) ResourceBlocks() ([]*ResourceBlock, error) {
+	return ListReferencedResourceBlocks(cs.Client, cs.resourceBlocks)
+}
+
+// ResourceZones gets the resource zones of the composition service. Each
+// resource zone lists the resource blocks that may be composed together and,
+// in its CollectionCapabilities, where composition requests are sent.
+func (cs *CompositionService) ResourceZones() ([]*Zone, error) {
+	return ListReferencedZones(cs.Client, cs.resourceZones)
+}
@@ -6,6 +6,9 @@ package redfish
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
 
 	"github.com/rocksolidlabs/gofish/common"
 )
@@ -323,8 +326,34 @@ const (
 	PushPowerButtonResetType ResetType = "PushPowerButton"
 	// NmiResetType shall be used to trigger a crash/core dump file
 	NmiResetType ResetType = "Nmi"
+	// ForceOnResetType shall be used to power on the machine immediately
+	ForceOnResetType ResetType = "ForceOn"
+	// GracefulRestartResetType shall be used to restart the machine waiting the OS shutdown gracefully
+	GracefulRestartResetType ResetType = "GracefulRestart"
+	// PowerCycleResetType shall be used to power off the machine and then power it on again
+	PowerCycleResetType ResetType = "PowerCycle"
 )
 
+// allowableValue checks the value of an action parameter against the
+// allowable values the service lists for it, if any. The allowable values
+// must be a slice of the type of the value. The parameter and resource name
+// the value in the error.
+func allowableValue(value, allowable interface{}, parameter, resource string) error {
+	values := reflect.ValueOf(allowable)
+	if values.Len() == 0 {
+		return nil
+	}
+
+	for i := 0; i < values.Len(); i++ {
+		if values.Index(i).Interface() == value {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s %v is not supported by this %s, expected one of %v",
+		parameter, value, resource, allowable)
+}
+
 // CSActions shall contain the available actions for this resource
 type CSActions struct {
 	// ComputerSystemReset shall perform a reset of the ComputerSystem. For
@@ -338,6 +367,16 @@ type CSActions struct {
 		ResetType []ResetType `json:"ResetType@Redfish.AllowableValues"`
 		Target    string
 	} `json:"#ComputerSystem.Reset"`
+	// ComputerSystemAddResourceBlock shall add a resource block to a
+	// composed system.
+	ComputerSystemAddResourceBlock struct {
+		Target string
+	} `json:"#ComputerSystem.AddResourceBlock"`
+	// ComputerSystemRemoveResourceBlock shall remove a resource block from a
+	// composed system.
+	ComputerSystemRemoveResourceBlock struct {
+		Target string
+	} `json:"#ComputerSystem.RemoveResourceBlock"`
 }
 
 // ComputerSystem is used to represent resources that represent a
@@ -476,6 +515,9 @@ type ComputerSystem struct {
 	UUID string
 	// Chassis is an array of references to the chassis in which this system is contained.
 	chassis []string
+	// resourceBlocks is an array of references to the resource blocks this
+	// system is composed from.
+	resourceBlocks []string
 }
 
 // UnmarshalJSON unmarshals a ComputerSystem object from the raw JSON.
@@ -519,6 +561,7 @@ func (computersystem *ComputerSystem) UnmarshalJSON(b []byte) error {
 	computersystem.pcieDevices = t.PCIeDevices.ToStrings()
 	computersystem.pcieFunctions = t.PCIeFunctions.ToStrings()
 	computersystem.chassis = t.Links.Chassis.ToStrings()
+	computersystem.resourceBlocks = t.Links.ResourceBlocks.ToStrings()
 
 	return nil
 }
@@ -645,6 +688,158 @@ func (computersystem *ComputerSystem) Storage() ([]*Storage, error) {
 	return ListReferencedStorages(computersystem.Client, computersystem.storage)
 }
 
+// ResourceBlocks gets the resource blocks this system is composed from.
+func (computersystem *ComputerSystem) ResourceBlocks() ([]*ResourceBlock, error) {
+	var result []*ResourceBlock
+	for _, link := range computersystem.resourceBlocks {
+		resourceblock, err := GetResourceBlock(computersystem.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, resourceblock)
+	}
+
+	return result, nil
+}
+
+// ComposeComputerSystem composes a new system from the given resource blocks
+// by posting to the systems collection at uri.
+func ComposeComputerSystem(c common.Client, uri, name string, blocks []*ResourceBlock) (*ComputerSystem, error) {
+	if len(blocks) == 0 {
+		return nil, fmt.Errorf("at least one resource block is required to compose a system")
+	}
+
+	t := struct {
+		Name  string `json:",omitempty"`
+		Links struct {
+			ResourceBlocks common.Links
+		}
+	}{
+		Name: name,
+	}
+	t.Links.ResourceBlocks = resourceBlockLinks(blocks)
+
+	payload, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Post(uri, payload)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if location := resp.Header.Get("Location"); location != "" {
+		return GetComputerSystem(c, location)
+	}
+
+	var computersystem ComputerSystem
+	err = json.NewDecoder(resp.Body).Decode(&computersystem)
+	if err != nil {
+		return nil, err
+	}
+
+	computersystem.SetClient(c)
+	return &computersystem, nil
+}
+
+// Decompose deletes a composed system, returning its resource blocks to the
+// composition service.
+func (computersystem *ComputerSystem) Decompose() error {
+	if computersystem.SystemType != ComposedSystemType {
+		return fmt.Errorf("only composed systems can be decomposed")
+	}
+
+	resp, err := computersystem.Client.Delete(computersystem.ODataID)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// AddResourceBlock adds a resource block to a composed system. The
+// AddResourceBlock action is used when the service supports it, otherwise
+// the system's resource block links are updated.
+func (computersystem *ComputerSystem) AddResourceBlock(block *ResourceBlock) error {
+	for _, link := range computersystem.resourceBlocks {
+		if link == block.ODataID {
+			return nil
+		}
+	}
+
+	links := common.Links{}
+	for _, link := range computersystem.resourceBlocks {
+		links = append(links, common.Link(link))
+	}
+	links = append(links, common.Link(block.ODataID))
+
+	err := computersystem.changeResourceBlocks(
+		computersystem.Actions.ComputerSystemAddResourceBlock.Target, block, links)
+	if err != nil {
+		return err
+	}
+
+	computersystem.resourceBlocks = links.ToStrings()
+	return nil
+}
+
+// RemoveResourceBlock removes a resource block from a composed system. The
+// RemoveResourceBlock action is used when the service supports it, otherwise
+// the system's resource block links are updated.
+func (computersystem *ComputerSystem) RemoveResourceBlock(block *ResourceBlock) error {
+	links := common.Links{}
+	for _, link := range computersystem.resourceBlocks {
+		if link != block.ODataID {
+			links = append(links, common.Link(link))
+		}
+	}
+
+	if len(links) == len(computersystem.resourceBlocks) {
+		return nil
+	}
+
+	err := computersystem.changeResourceBlocks(
+		computersystem.Actions.ComputerSystemRemoveResourceBlock.Target, block, links)
+	if err != nil {
+		return err
+	}
+
+	computersystem.resourceBlocks = links.ToStrings()
+	return nil
+}
+
+func (computersystem *ComputerSystem) changeResourceBlocks(target string, block *ResourceBlock, links common.Links) error {
+	if computersystem.SystemType != ComposedSystemType {
+		return fmt.Errorf("resource blocks can only be changed on composed systems")
+	}
+
+	var resp *http.Response
+	var err error
+	if target != "" {
+		t := struct {
+			ResourceBlock common.Link
+		}{ResourceBlock: common.Link(block.ODataID)}
+		resp, err = computersystem.Post(target, t)
+	} else {
+		var t struct {
+			Links struct {
+				ResourceBlocks common.Links
+			}
+		}
+		t.Links.ResourceBlocks = links
+		resp, err = computersystem.Patch(computersystem.ODataID, t)
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
 // CSLinks are references to resources that are related to, but not contained
 // by (subordinate to), this resource.
 type CSLinks struct {
@@ -6,6 +6,7 @@ package redfish
 
 import (
 	"encoding/json"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -230,3 +231,104 @@ func TestComputerSystem(t *testing.T) {
 		t.Errorf("Received invalid chassis reference: %s", result.chassis[0])
 	}
 }
+
+var composedSystemBody = `{
+		"@odata.id": "/redfish/v1/Systems/Composed1",
+		"Id": "Composed1",
+		"Name": "Composed System",
+		"SystemType": "Composed",
+		"Links": {
+			"ResourceBlocks": [
+				{
+					"@odata.id": "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1"
+				}
+			]
+		}
+	}`
+
+// TestComposeComputerSystem tests composing a system from resource blocks.
+func TestComposeComputerSystem(t *testing.T) {
+	client := &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/Systems/Composed1": composedSystemBody,
+		},
+		headers: map[string]http.Header{
+			"POST /redfish/v1/Systems": {"Location": []string{"/redfish/v1/Systems/Composed1"}},
+		},
+	}
+
+	blocks := []*ResourceBlock{
+		{ODataID: "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1"},
+	}
+	result, err := ComposeComputerSystem(client, "/redfish/v1/Systems", "Composed System", blocks)
+	if err != nil {
+		t.Fatalf("Error composing system: %s", err)
+	}
+
+	expected := `{"Name":"Composed System","Links":{"ResourceBlocks":[{"@odata.id":"/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1"}]}}`
+	if client.calls[0].Payload != expected {
+		t.Errorf("Unexpected compose payload: %s", client.calls[0].Payload)
+	}
+
+	if result.ID != "Composed1" || len(result.resourceBlocks) != 1 {
+		t.Errorf("Unexpected composed system: %s %v", result.ID, result.resourceBlocks)
+	}
+
+	_, err = ComposeComputerSystem(client, "/redfish/v1/Systems", "Empty", nil)
+	if err == nil {
+		t.Error("Expected an error composing a system without resource blocks")
+	}
+}
+
+// TestComputerSystemResourceBlocks tests changing the resource blocks of a
+// composed system.
+func TestComputerSystemResourceBlocks(t *testing.T) {
+	var result ComputerSystem
+	err := json.NewDecoder(strings.NewReader(composedSystemBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	drives := &ResourceBlock{ODataID: "/redfish/v1/CompositionService/ResourceBlocks/DriveBlock3"}
+	err = result.AddResourceBlock(drives)
+	if err != nil {
+		t.Errorf("Error adding resource block: %s", err)
+	}
+
+	expected := `{"Links":{"ResourceBlocks":[{"@odata.id":"/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1"},{"@odata.id":"/redfish/v1/CompositionService/ResourceBlocks/DriveBlock3"}]}}`
+	if client.calls[0].Method != http.MethodPatch || client.calls[0].Payload != expected {
+		t.Errorf("Unexpected add request: %s %s", client.calls[0].Method, client.calls[0].Payload)
+	}
+
+	result.Actions.ComputerSystemRemoveResourceBlock.Target = "/redfish/v1/Systems/Composed1/Actions/ComputerSystem.RemoveResourceBlock"
+	err = result.RemoveResourceBlock(drives)
+	if err != nil {
+		t.Errorf("Error removing resource block: %s", err)
+	}
+
+	expected = `{"ResourceBlock":{"@odata.id":"/redfish/v1/CompositionService/ResourceBlocks/DriveBlock3"}}`
+	if client.calls[1].URL != result.Actions.ComputerSystemRemoveResourceBlock.Target || client.calls[1].Payload != expected {
+		t.Errorf("Unexpected remove request: %s %s", client.calls[1].URL, client.calls[1].Payload)
+	}
+
+	if len(result.resourceBlocks) != 1 {
+		t.Errorf("Unexpected resource blocks: %v", result.resourceBlocks)
+	}
+
+	err = result.RemoveResourceBlock(drives)
+	if err != nil || len(client.calls) != 2 {
+		t.Errorf("Expected no request removing a block not in the system: %v", err)
+	}
+
+	err = result.Decompose()
+	if err != nil {
+		t.Errorf("Error decomposing system: %s", err)
+	}
+
+	if client.calls[2].Method != http.MethodDelete || client.calls[2].URL != "/redfish/v1/Systems/Composed1" {
+		t.Errorf("Unexpected decompose request: %s %s", client.calls[2].Method, client.calls[2].URL)
+	}
+}
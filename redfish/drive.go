@@ -6,6 +6,11 @@ package redfish
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/rocksolidlabs/gofish/common"
 )
@@ -114,6 +119,22 @@ const (
 	InAFailedArrayStatusIndicator StatusIndicator = "InAFailedArray"
 )
 
+// DataSanitizationType is the method used to securely erase a drive.
+type DataSanitizationType string
+
+const (
+	// BlockEraseDataSanitizationType shall sanitize the data on the drive by
+	// performing a block erase.
+	BlockEraseDataSanitizationType DataSanitizationType = "BlockErase"
+	// CryptographicEraseDataSanitizationType shall sanitize the data on the
+	// drive by performing a cryptographic erase, changing the media
+	// encryption key.
+	CryptographicEraseDataSanitizationType DataSanitizationType = "CryptographicErase"
+	// OverwriteDataSanitizationType shall sanitize the data on the drive by
+	// overwriting it.
+	OverwriteDataSanitizationType DataSanitizationType = "Overwrite"
+)
+
 // Drive is used to represent a disk drive or other physical storage
 // medium for a Redfish implementation.
 type Drive struct {
@@ -222,6 +243,17 @@ type Drive struct {
 	pcieFunctions []string
 	// PCIeFunctionCount is the number of PCIeFunctions.
 	PCIeFunctionCount int
+	// SupportedResetTypes, if provided, is the reset types this drive
+	// supports.
+	SupportedResetTypes []ResetType
+	// SupportedSanitizationTypes, if provided, is the methods of secure erase
+	// this drive supports.
+	SupportedSanitizationTypes []DataSanitizationType
+	// rawData holds the original serialized JSON so we can compare updates.
+	rawData           []byte
+	resetTarget       string
+	revertTarget      string
+	secureEraseTarget string
 }
 
 // UnmarshalJSON unmarshals a Drive object from the raw JSON.
@@ -240,10 +272,24 @@ func (drive *Drive) UnmarshalJSON(b []byte) error {
 		Volumes            common.Links
 		VolumeCount        int `json:"Volumes@odata.count"`
 	}
+	type actions struct {
+		DriveReset struct {
+			AllowableValues []ResetType `json:"ResetType@Redfish.AllowableValues"`
+			Target          string
+		} `json:"#Drive.Reset"`
+		RevertToOriginalFactoryState struct {
+			Target string
+		} `json:"#Drive.RevertToOriginalFactoryState"`
+		SecureErase struct {
+			AllowableValues []DataSanitizationType `json:"SanitizationType@Redfish.AllowableValues"`
+			Target          string
+		} `json:"#Drive.SecureErase"`
+	}
 	var t struct {
 		temp
 		Links    links
 		Assembly common.Link
+		Actions  actions
 	}
 
 	err := json.Unmarshal(b, &t)
@@ -261,7 +307,201 @@ func (drive *Drive) UnmarshalJSON(b []byte) error {
 	drive.VolumesCount = t.Links.VolumeCount
 	drive.pcieFunctions = t.Links.PCIeFunctions.ToStrings()
 	drive.PCIeFunctionCount = t.Links.PCIeFunctionsCount
+	drive.resetTarget = t.Actions.DriveReset.Target
+	drive.SupportedResetTypes = t.Actions.DriveReset.AllowableValues
+	drive.revertTarget = t.Actions.RevertToOriginalFactoryState.Target
+	drive.secureEraseTarget = t.Actions.SecureErase.Target
+	drive.SupportedSanitizationTypes = t.Actions.SecureErase.AllowableValues
+
+	// This is a read/write object, so we need to save the raw object data for later
+	drive.rawData = b
+
+	return nil
+}
+
+// Update commits updates to this object's properties to the running system.
+// Only the properties that were changed are sent to the service.
+func (drive *Drive) Update() error {
+	// Get a representation of the object's original state so we can find what
+	// to update.
+	original := new(Drive)
+	err := original.UnmarshalJSON(drive.rawData)
+	if err != nil {
+		return err
+	}
+
+	readWriteFields := []string{
+		"HotspareReplacementMode",
+		"HotspareType",
+		"IndicatorLED",
+	}
+
+	originalElement := reflect.ValueOf(original).Elem()
+	currentElement := reflect.ValueOf(drive).Elem()
+
+	return drive.Entity.Update(drive.ODataID, originalElement, currentElement, readWriteFields, &drive.rawData)
+}
+
+// Reset resets the drive. The reset type is checked against the types the
+// service allows, if it lists them.
+func (drive *Drive) Reset(resetType ResetType) error {
+	if drive.resetTarget == "" {
+		return fmt.Errorf("Reset is not supported by this service")
+	}
+
+	err := allowableValue(resetType, drive.SupportedResetTypes, "reset type", "drive")
+	if err != nil {
+		return err
+	}
+
+	t := struct {
+		ResetType ResetType
+	}{ResetType: resetType}
+
+	resp, err := drive.Post(drive.resetTarget, t)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// Revert reverts a self-encrypting drive to its original factory state,
+// which erases all data on it.
+func (drive *Drive) Revert() error {
+	if drive.revertTarget == "" {
+		return fmt.Errorf("RevertToOriginalFactoryState is not supported by this service")
+	}
+
+	resp, err := drive.Post(drive.revertTarget, struct{}{})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// SecureErase securely erases the contents of the drive. The sanitization
+// type may be empty to use the default of the service, and overwritePasses
+// is only used with OverwriteDataSanitizationType, zero for the default.
+// The erase usually runs as a task, which is returned to be monitored. The
+// returned monitor is nil if the service erased the drive before
+// responding.
+func (drive *Drive) SecureErase(sanitizationType DataSanitizationType, overwritePasses int) (*TaskMonitor, error) {
+	if drive.secureEraseTarget == "" {
+		return nil, fmt.Errorf("SecureErase is not supported by this service")
+	}
 
+	if sanitizationType != "" {
+		err := allowableValue(sanitizationType, drive.SupportedSanitizationTypes, "sanitization type", "drive")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if overwritePasses < 0 || (overwritePasses > 0 && sanitizationType != OverwriteDataSanitizationType) {
+		return nil, fmt.Errorf("overwrite passes must be zero, or positive with an overwrite, got %d", overwritePasses)
+	}
+
+	t := struct {
+		SanitizationType DataSanitizationType `json:",omitempty"`
+		OverwritePasses  int                  `json:",omitempty"`
+	}{
+		SanitizationType: sanitizationType,
+		OverwritePasses:  overwritePasses,
+	}
+
+	resp, err := drive.Post(drive.secureEraseTarget, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		resp.Body.Close()
+		return nil, nil
+	}
+
+	return NewTaskMonitor(drive.Client, resp)
+}
+
+// SecureEraseAndVerify securely erases the drive like SecureErase, and waits
+// for the erase to finish, polling at the given interval until the timeout
+// passes. A timeout of zero waits indefinitely. The erase task, if the
+// service runs one, must complete with an OK status, and the drive is then
+// read again until it no longer reports an erase or sanitize operation in
+// progress, as services often keep reporting the operation for a while after
+// the request or task finished. Finally the drive must not be in a critical
+// state.
+//
+// This is a weak check: it only trusts what the service reports about the
+// erase, as Redfish gives no way to read back the media. It cannot prove
+// that the data is unrecoverable.
+func (drive *Drive) SecureEraseAndVerify(sanitizationType DataSanitizationType, overwritePasses int, interval, timeout time.Duration) error {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	monitor, err := drive.SecureErase(sanitizationType, overwritePasses)
+	if err != nil {
+		return err
+	}
+
+	if monitor != nil {
+		// The task gets what is left of the timeout, but at least some of it.
+		remaining := time.Duration(0)
+		if !deadline.IsZero() {
+			remaining = time.Until(deadline)
+			if remaining <= 0 {
+				remaining = time.Nanosecond
+			}
+		}
+
+		task, err := monitor.Wait(interval, remaining)
+		if err != nil {
+			return err
+		}
+		if task.TaskState != CompletedTaskState {
+			return fmt.Errorf("secure erase task %s ended in state %s", task.ID, task.TaskState)
+		}
+		if task.TaskStatus != "" && task.TaskStatus != common.OKHealth {
+			return fmt.Errorf("secure erase task %s completed with status %s", task.ID, task.TaskStatus)
+		}
+	}
+
+	for {
+		erased, err := GetDrive(drive.Client, drive.ODataID)
+		if err != nil {
+			return err
+		}
+
+		operation := eraseOperation(erased)
+		if operation == nil {
+			if erased.Status.Health == common.CriticalHealth {
+				return fmt.Errorf("drive %s is in a critical state after the secure erase", drive.ODataID)
+			}
+			return nil
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return fmt.Errorf("drive %s still reports %s in progress (%d%% complete)",
+				drive.ODataID, operation.OperationName, operation.PercentageComplete)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// eraseOperation gets the erase or sanitize operation the drive reports in
+// progress, or nil if there is none.
+func eraseOperation(drive *Drive) *common.Operations {
+	for i := range drive.Operations {
+		name := strings.ToLower(drive.Operations[i].OperationName)
+		if strings.Contains(name, "erase") || strings.Contains(name, "sanitiz") {
+			return &drive.Operations[i]
+		}
+	}
 	return nil
 }
 
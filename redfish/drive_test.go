@@ -6,8 +6,12 @@ package redfish
 
 import (
 	"encoding/json"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/rocksolidlabs/gofish/common"
 )
 
 var driveBody = strings.NewReader(
@@ -125,3 +129,194 @@ func TestDrive(t *testing.T) {
 		t.Errorf("Invalid chassis link: %s", result.chassis)
 	}
 }
+
+var driveActionsBody = `{
+		"@odata.id": "/redfish/v1/Chassis/1/Drives/0",
+		"Id": "0",
+		"HotspareType": "None",
+		"HotspareReplacementMode": "Revertible",
+		"IndicatorLED": "Off",
+		"Actions": {
+			"#Drive.Reset": {
+				"target": "/redfish/v1/Chassis/1/Drives/0/Actions/Drive.Reset",
+				"ResetType@Redfish.AllowableValues": ["ForceOff", "ForceOn"]
+			},
+			"#Drive.SecureErase": {
+				"target": "/redfish/v1/Chassis/1/Drives/0/Actions/Drive.SecureErase",
+				"SanitizationType@Redfish.AllowableValues": ["CryptographicErase", "Overwrite"]
+			},
+			"#Drive.RevertToOriginalFactoryState": {
+				"target": "/redfish/v1/Chassis/1/Drives/0/Actions/Drive.RevertToOriginalFactoryState"
+			}
+		}
+	}`
+
+// TestDriveUpdate tests the Update call.
+func TestDriveUpdate(t *testing.T) {
+	var result Drive
+	err := json.NewDecoder(strings.NewReader(driveActionsBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	result.HotspareType = GlobalHotspareType
+	result.IndicatorLED = common.LitIndicatorLED
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+
+	expected := `{"HotspareType":"Global","IndicatorLED":"Lit"}`
+	if len(client.calls) != 1 || client.calls[0].Method != "PATCH" ||
+		client.calls[0].URL != "/redfish/v1/Chassis/1/Drives/0" || client.calls[0].Payload != expected {
+		t.Errorf("Unexpected update request: %v", client.calls)
+	}
+}
+
+// TestDriveActions tests the Reset and Revert calls.
+func TestDriveActions(t *testing.T) {
+	var result Drive
+	err := json.NewDecoder(strings.NewReader(driveActionsBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	if result.Reset(GracefulRestartResetType) == nil {
+		t.Error("Expected error for a reset type the drive does not allow")
+	}
+
+	err = result.Reset(ForceOffResetType)
+	if err != nil {
+		t.Errorf("Error making Reset call: %s", err)
+	}
+
+	err = result.Revert()
+	if err != nil {
+		t.Errorf("Error making Revert call: %s", err)
+	}
+
+	if len(client.calls) != 2 ||
+		client.calls[0].URL != "/redfish/v1/Chassis/1/Drives/0/Actions/Drive.Reset" ||
+		client.calls[0].Payload != `{"ResetType":"ForceOff"}` ||
+		client.calls[1].URL != "/redfish/v1/Chassis/1/Drives/0/Actions/Drive.RevertToOriginalFactoryState" {
+		t.Errorf("Unexpected action requests: %v", client.calls)
+	}
+
+	var unsupported Drive
+	err = json.Unmarshal([]byte(`{"@odata.id": "/redfish/v1/Chassis/1/Drives/1"}`), &unsupported)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+	if unsupported.Reset(OnResetType) == nil {
+		t.Error("Expected error for a drive without the reset action")
+	}
+	if unsupported.Revert() == nil {
+		t.Error("Expected error for a drive without the revert action")
+	}
+	if _, err := unsupported.SecureErase("", 0); err == nil {
+		t.Error("Expected error for a drive without the secure erase action")
+	}
+}
+
+// TestDriveSecureErase tests a secure erase run as a task and verified.
+func TestDriveSecureErase(t *testing.T) {
+	eraseTarget := "POST /redfish/v1/Chassis/1/Drives/0/Actions/Drive.SecureErase"
+	client := &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/TaskService/Tasks/1": `{
+				"@odata.id": "/redfish/v1/TaskService/Tasks/1",
+				"Id": "1",
+				"TaskState": "Completed",
+				"TaskStatus": "OK"
+			}`,
+			"GET /redfish/v1/Chassis/1/Drives/0": `{
+				"@odata.id": "/redfish/v1/Chassis/1/Drives/0",
+				"Id": "0",
+				"Status": {"State": "Enabled", "Health": "OK"}
+			}`,
+		},
+		headers: map[string]http.Header{
+			eraseTarget: {"Location": []string{"/redfish/v1/TaskService/Tasks/1"}},
+		},
+		statuses: map[string]int{
+			eraseTarget: http.StatusAccepted,
+		},
+	}
+
+	var result Drive
+	err := json.NewDecoder(strings.NewReader(driveActionsBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+	result.SetClient(client)
+
+	if _, err := result.SecureErase(BlockEraseDataSanitizationType, 0); err == nil {
+		t.Error("Expected error for a sanitization type the drive does not allow")
+	}
+	if _, err := result.SecureErase(CryptographicEraseDataSanitizationType, 3); err == nil {
+		t.Error("Expected error for overwrite passes without an overwrite")
+	}
+	if len(client.calls) != 0 {
+		t.Errorf("Invalid secure erase was sent: %v", client.calls)
+	}
+
+	monitor, err := result.SecureErase(OverwriteDataSanitizationType, 3)
+	if err != nil {
+		t.Errorf("Error making SecureErase call: %s", err)
+	}
+	if monitor == nil || monitor.URI != "/redfish/v1/TaskService/Tasks/1" {
+		t.Errorf("Unexpected task monitor: %v", monitor)
+	}
+	if client.calls[0].Payload != `{"SanitizationType":"Overwrite","OverwritePasses":3}` {
+		t.Errorf("Unexpected secure erase payload: %s", client.calls[0].Payload)
+	}
+
+	err = result.SecureEraseAndVerify(CryptographicEraseDataSanitizationType, 0, time.Millisecond, time.Second)
+	if err != nil {
+		t.Errorf("Error verifying secure erase: %s", err)
+	}
+
+	// A timeout of zero waits indefinitely.
+	err = result.SecureEraseAndVerify(CryptographicEraseDataSanitizationType, 0, time.Millisecond, 0)
+	if err != nil {
+		t.Errorf("Error verifying secure erase without a timeout: %s", err)
+	}
+
+	client.responses["GET /redfish/v1/Chassis/1/Drives/0"] = `{
+		"@odata.id": "/redfish/v1/Chassis/1/Drives/0",
+		"Id": "0",
+		"Operations": [{"OperationName": "Sanitize", "PercentageComplete": 40}]
+	}`
+	client.calls = nil
+	err = result.SecureEraseAndVerify(CryptographicEraseDataSanitizationType, 0, time.Millisecond, 20*time.Millisecond)
+	if err == nil {
+		t.Error("Expected error for a drive still being sanitized")
+	}
+
+	reads := 0
+	for _, call := range client.calls {
+		if call.Method == http.MethodGet && call.URL == "/redfish/v1/Chassis/1/Drives/0" {
+			reads++
+		}
+	}
+	if reads < 2 {
+		t.Errorf("The drive should be polled until the timeout, got %d reads", reads)
+	}
+
+	client.responses["GET /redfish/v1/TaskService/Tasks/1"] = `{
+		"@odata.id": "/redfish/v1/TaskService/Tasks/1",
+		"Id": "1",
+		"TaskState": "Exception",
+		"TaskStatus": "Critical"
+	}`
+	err = result.SecureEraseAndVerify(CryptographicEraseDataSanitizationType, 0, time.Millisecond, time.Second)
+	if err == nil {
+		t.Error("Expected error for a failed secure erase task")
+	}
+}
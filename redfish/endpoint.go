@@ -255,3 +255,17 @@ type PciID struct {
 	// VendorID shall be the PCI Vendor ID of the PCIe device function.
 	VendorID string `json:"VendorId"`
 }
+
+// Ports gets the fabric ports utilized by this endpoint.
+func (endpoint *Endpoint) Ports() ([]*Port, error) {
+	var result []*Port
+	for _, link := range endpoint.ports {
+		port, err := GetPort(endpoint.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, port)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,395 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// AccountProviderTypes is the type of an external account provider.
+type AccountProviderTypes string
+
+const (
+	// RedfishServiceAccountProviderTypes shall be a DMTF Redfish
+	// Specification-comformant service. The ServiceAddresses format shall
+	// contain a set of URIs that correspond to a Redfish account service.
+	RedfishServiceAccountProviderTypes AccountProviderTypes = "RedfishService"
+	// ActiveDirectoryServiceAccountProviderTypes shall be a Microsoft Active
+	// Directory Technical Specification-comformant service. The
+	// ServiceAddresses format shall contain a set of fully qualified domain
+	// names (FQDN) or NetBIOS names that links to the set of domain servers
+	// for the Active Directory service.
+	ActiveDirectoryServiceAccountProviderTypes AccountProviderTypes = "ActiveDirectoryService"
+	// LDAPServiceAccountProviderTypes shall be an RFC4511-conformant
+	// service. The ServiceAddresses format shall contain a set of fully
+	// qualified domain names (FQDN) that links to the set of LDAP servers for
+	// the service.
+	LDAPServiceAccountProviderTypes AccountProviderTypes = "LDAPService"
+	// OEMAccountProviderTypes An OEM-specific external authority.
+	OEMAccountProviderTypes AccountProviderTypes = "OEM"
+	// TACACSplusAccountProviderTypes shall be an RFC8907-conformant service.
+	TACACSplusAccountProviderTypes AccountProviderTypes = "TACACSplus"
+	// OAuth2AccountProviderTypes shall be an RFC6749-conformant service.
+	OAuth2AccountProviderTypes AccountProviderTypes = "OAuth2"
+)
+
+// AuthenticationTypes is the type of authentication used to connect to an
+// external account provider.
+type AuthenticationTypes string
+
+const (
+	// TokenAuthenticationTypes An opaque authentication token.
+	TokenAuthenticationTypes AuthenticationTypes = "Token"
+	// KerberosKeytabAuthenticationTypes A Kerberos keytab.
+	KerberosKeytabAuthenticationTypes AuthenticationTypes = "KerberosKeytab"
+	// UsernameAndPasswordAuthenticationTypes A user name and password
+	// combination.
+	UsernameAndPasswordAuthenticationTypes AuthenticationTypes = "UsernameAndPassword"
+	// OEMAuthenticationTypes An OEM-specific authentication mechanism.
+	OEMAuthenticationTypes AuthenticationTypes = "OEM"
+)
+
+// Authentication shall contain the information required to authenticate to
+// the external service. Secrets are never returned by the service, so they
+// are only sent when set.
+type Authentication struct {
+	// AuthenticationType shall contain the type of authentication used to
+	// connect to the external account provider.
+	AuthenticationType AuthenticationTypes `json:",omitempty"`
+	// KerberosKeytab shall contain a Base64-encoded version of the Kerberos
+	// keytab for this service.
+	KerberosKeytab string `json:",omitempty"`
+	// Password shall contain the password for this service.
+	Password string `json:",omitempty"`
+	// Token shall contain the token for this service.
+	Token string `json:",omitempty"`
+	// Username shall contain the user name for this service.
+	Username string `json:",omitempty"`
+}
+
+// LDAPSearchSettings shall contain all required settings to search a
+// generic LDAP service.
+type LDAPSearchSettings struct {
+	// BaseDistinguishedNames shall contain an array of base distinguished
+	// names to use to search an external LDAP service.
+	BaseDistinguishedNames []string `json:",omitempty"`
+	// GroupNameAttribute shall contain the attribute name that contains the
+	// LDAP group name.
+	GroupNameAttribute string `json:",omitempty"`
+	// GroupsAttribute shall contain the attribute name that contains the
+	// groups for an LDAP user entry.
+	GroupsAttribute string `json:",omitempty"`
+	// SSHKeyAttribute shall contain the attribute name that contains the
+	// LDAP user's SSH public key.
+	SSHKeyAttribute string `json:",omitempty"`
+	// UsernameAttribute shall contain the attribute name that contains the
+	// LDAP user name.
+	UsernameAttribute string `json:",omitempty"`
+}
+
+// LDAPService shall contain all required settings to parse a generic LDAP
+// service.
+type LDAPService struct {
+	// SearchSettings shall contain the required settings to search an
+	// external LDAP service.
+	SearchSettings LDAPSearchSettings
+}
+
+// RoleMapping shall contain mapping rules that are used to convert the
+// external account providers account information to the local Redfish role.
+type RoleMapping struct {
+	// LocalRole shall contain the RoleId property value within a role
+	// resource on this Redfish service to which to map the remote user or
+	// group.
+	LocalRole string
+	// RemoteGroup shall contain the name of the remote group, or the remote
+	// role in the case of a Redfish service, that maps to the local Redfish
+	// role to which this entity links.
+	RemoteGroup string `json:",omitempty"`
+	// RemoteUser shall contain the name of the remote user that maps to the
+	// local Redfish role to which this entity links.
+	RemoteUser string `json:",omitempty"`
+}
+
+// ExternalAccountProviderSettings shall contain properties that represent
+// external user account services, such as the LDAP and ActiveDirectory
+// settings of the account service.
+type ExternalAccountProviderSettings struct {
+	// AccountProviderType shall contain the type of external account
+	// provider to which this service connects.
+	AccountProviderType AccountProviderTypes
+	// Authentication shall contain the authentication information for the
+	// external account provider.
+	Authentication Authentication
+	// LDAPService shall contain any additional mapping information needed
+	// to parse a generic LDAP service.
+	LDAPService LDAPService
+	// PasswordSet shall contain true if a valid value was provided for the
+	// Password property. Otherwise, the property shall contain false.
+	PasswordSet bool
+	// Priority shall contain the assigned priority for the specified
+	// external account provider. The value 0 shall indicate the highest
+	// priority. It is nil if the service does not report it, and is not
+	// sent when nil.
+	Priority *int
+	// RemoteRoleMapping shall contain a set of the mapping rules that are
+	// used to convert the external account providers account information to
+	// the local Redfish role.
+	RemoteRoleMapping []RoleMapping
+	// ServiceAddresses shall contain the addresses of the account providers
+	// to which this external account provider links.
+	ServiceAddresses []string
+	// ServiceEnabled shall indicate whether this service is enabled.
+	ServiceEnabled bool
+	// TimeoutSeconds shall contain the period of time, in seconds, this
+	// account service will wait for a response from an address of a user
+	// account provider before timing out.
+	TimeoutSeconds int
+	// certificates shall contain a link to a resource collection of type
+	// CertificateCollection that contains certificates the external account
+	// provider uses.
+	certificates string
+}
+
+// UnmarshalJSON unmarshals an ExternalAccountProviderSettings object from
+// the raw JSON.
+func (settings *ExternalAccountProviderSettings) UnmarshalJSON(b []byte) error {
+	type temp ExternalAccountProviderSettings
+	var t struct {
+		temp
+		Certificates common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*settings = ExternalAccountProviderSettings(t.temp)
+
+	// Extract the links to other entities for later
+	settings.certificates = string(t.Certificates)
+
+	return nil
+}
+
+// MarshalJSON marshals the writable properties of the settings. Empty
+// authentication and LDAP search settings are left out so that secrets the
+// service does not return are not cleared.
+func (settings ExternalAccountProviderSettings) MarshalJSON() ([]byte, error) {
+	return json.Marshal(settings.payload())
+}
+
+// accountProviderPayload holds the writable properties of an external
+// account provider.
+type accountProviderPayload struct {
+	AccountProviderType AccountProviderTypes `json:",omitempty"`
+	Authentication      *Authentication      `json:",omitempty"`
+	LDAPService         *LDAPService         `json:",omitempty"`
+	Priority            *int                 `json:",omitempty"`
+	RemoteRoleMapping   *[]RoleMapping       `json:",omitempty"`
+	ServiceAddresses    *[]string            `json:",omitempty"`
+	ServiceEnabled      bool
+	TimeoutSeconds      int `json:",omitempty"`
+}
+
+func (settings ExternalAccountProviderSettings) payload() accountProviderPayload {
+	t := accountProviderPayload{
+		Priority:       settings.Priority,
+		ServiceEnabled: settings.ServiceEnabled,
+		TimeoutSeconds: settings.TimeoutSeconds,
+	}
+	if settings.Authentication != (Authentication{}) {
+		t.Authentication = &settings.Authentication
+	}
+	if !reflect.DeepEqual(settings.LDAPService, LDAPService{}) {
+		t.LDAPService = &settings.LDAPService
+	}
+	if settings.RemoteRoleMapping != nil {
+		t.RemoteRoleMapping = &settings.RemoteRoleMapping
+	}
+	if settings.ServiceAddresses != nil {
+		t.ServiceAddresses = &settings.ServiceAddresses
+	}
+	return t
+}
+
+// ExternalAccountProvider shall represent a remote authentication service
+// in the Redfish Specification.
+type ExternalAccountProvider struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// AccountProviderType shall contain the type of external account
+	// provider to which this service connects.
+	AccountProviderType AccountProviderTypes
+	// Authentication shall contain the authentication information for the
+	// external account provider.
+	Authentication Authentication
+	// Description provides a description of this resource.
+	Description string
+	// LDAPService shall contain any additional mapping information needed
+	// to parse a generic LDAP service.
+	LDAPService LDAPService
+	// Priority shall contain the assigned priority for the specified
+	// external account provider. The value 0 shall indicate the highest
+	// priority. It is nil if the service does not report it, and is not
+	// sent when nil.
+	Priority *int
+	// RemoteRoleMapping shall contain a set of the mapping rules that are
+	// used to convert the external account providers account information to
+	// the local Redfish role.
+	RemoteRoleMapping []RoleMapping
+	// ServiceAddresses shall contain the addresses of the account providers
+	// to which this external account provider links.
+	ServiceAddresses []string
+	// ServiceEnabled shall indicate whether this service is enabled.
+	ServiceEnabled bool
+	// TimeoutSeconds shall contain the period of time, in seconds, this
+	// account service will wait for a response from an address of a user
+	// account provider before timing out.
+	TimeoutSeconds int
+	// rawData holds the original serialized JSON so we can compare updates.
+	rawData []byte
+	// certificates shall contain a link to a resource collection of type
+	// CertificateCollection that contains certificates the external account
+	// provider uses.
+	certificates string
+}
+
+// UnmarshalJSON unmarshals an ExternalAccountProvider object from the raw
+// JSON.
+func (provider *ExternalAccountProvider) UnmarshalJSON(b []byte) error {
+	type temp ExternalAccountProvider
+	var t struct {
+		temp
+		Certificates common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*provider = ExternalAccountProvider(t.temp)
+
+	// Extract the links to other entities for later
+	provider.certificates = string(t.Certificates)
+
+	// This is a read/write object, so we need to save the raw object data for later
+	provider.rawData = b
+
+	return nil
+}
+
+// Update commits updates to this object's properties to the running system.
+func (provider *ExternalAccountProvider) Update() error {
+	// Get a representation of the object's original state so we can find what
+	// to update.
+	original := new(ExternalAccountProvider)
+	err := original.UnmarshalJSON(provider.rawData)
+	if err != nil {
+		return err
+	}
+
+	readWriteFields := []string{
+		"Authentication",
+		"LDAPService",
+		"Priority",
+		"RemoteRoleMapping",
+		"ServiceAddresses",
+		"ServiceEnabled",
+		"TimeoutSeconds",
+	}
+
+	originalElement := reflect.ValueOf(original).Elem()
+	currentElement := reflect.ValueOf(provider).Elem()
+
+	return provider.Entity.Update(provider.ODataID, originalElement, currentElement, readWriteFields, &provider.rawData)
+}
+
+// Delete removes the external account provider from the service.
+func (provider *ExternalAccountProvider) Delete() error {
+	resp, err := provider.Client.Delete(provider.ODataID)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// GetExternalAccountProvider will get an ExternalAccountProvider instance
+// from the service.
+func GetExternalAccountProvider(c common.Client, uri string) (*ExternalAccountProvider, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var provider ExternalAccountProvider
+	err = json.NewDecoder(resp.Body).Decode(&provider)
+	if err != nil {
+		return nil, err
+	}
+
+	provider.SetClient(c)
+	return &provider, nil
+}
+
+// ListReferencedExternalAccountProviders gets the collection of
+// ExternalAccountProvider from a provided reference.
+func ListReferencedExternalAccountProviders(c common.Client, link string) ([]*ExternalAccountProvider, error) {
+	var result []*ExternalAccountProvider
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, providerLink := range links.ItemLinks {
+		provider, err := GetExternalAccountProvider(c, providerLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, provider)
+	}
+
+	return result, nil
+}
+
+// Certificates gets the certificates used to connect to the external
+// account provider.
+func (provider *ExternalAccountProvider) Certificates() ([]*Certificate, error) {
+	return ListReferencedCertificates(provider.Client, provider.certificates)
+}
+
+// settings gets the configuration of the external account provider.
+func (provider *ExternalAccountProvider) settings() ExternalAccountProviderSettings {
+	return ExternalAccountProviderSettings{
+		AccountProviderType: provider.AccountProviderType,
+		Authentication:      provider.Authentication,
+		LDAPService:         provider.LDAPService,
+		Priority:            provider.Priority,
+		RemoteRoleMapping:   provider.RemoteRoleMapping,
+		ServiceAddresses:    provider.ServiceAddresses,
+		ServiceEnabled:      provider.ServiceEnabled,
+		TimeoutSeconds:      provider.TimeoutSeconds,
+	}
+}
@@ -0,0 +1,104 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+var externalAccountProviderBody = `{
+		"@odata.type": "#ExternalAccountProvider.v1_6_0.ExternalAccountProvider",
+		"@odata.id": "/redfish/v1/AccountService/ExternalAccountProviders/ExternalRedfishService",
+		"Id": "ExternalRedfishService",
+		"Name": "Remote Redfish Service",
+		"Description": "Remote Redfish Service providing additional Accounts",
+		"AccountProviderType": "RedfishService",
+		"ServiceAddresses": [
+			"http://redfish.dmtf.org/redfish/v1/AccountService"
+		],
+		"Authentication": {
+			"AuthenticationType": "Token",
+			"Token": null
+		},
+		"RemoteRoleMapping": [
+			{
+				"RemoteGroup": "Admin",
+				"LocalRole": "Administrator"
+			},
+			{
+				"RemoteGroup": "Operator",
+				"LocalRole": "Operator"
+			}
+		],
+		"Certificates": {
+			"@odata.id": "/redfish/v1/AccountService/ExternalAccountProviders/ExternalRedfishService/Certificates"
+		}
+	}`
+
+// TestExternalAccountProvider tests the parsing of ExternalAccountProvider objects.
+func TestExternalAccountProvider(t *testing.T) {
+	var result ExternalAccountProvider
+	err := json.NewDecoder(strings.NewReader(externalAccountProviderBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "ExternalRedfishService" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.AccountProviderType != RedfishServiceAccountProviderTypes {
+		t.Errorf("Invalid account provider type: %s", result.AccountProviderType)
+	}
+
+	if result.Authentication.AuthenticationType != TokenAuthenticationTypes {
+		t.Errorf("Invalid authentication type: %s", result.Authentication.AuthenticationType)
+	}
+
+	if len(result.RemoteRoleMapping) != 2 || result.RemoteRoleMapping[1].LocalRole != "Operator" {
+		t.Errorf("Invalid remote role mapping: %v", result.RemoteRoleMapping)
+	}
+
+	if result.certificates != "/redfish/v1/AccountService/ExternalAccountProviders/ExternalRedfishService/Certificates" {
+		t.Errorf("Invalid certificates link: %s", result.certificates)
+	}
+}
+
+// TestExternalAccountProviderUpdate tests the Update call.
+func TestExternalAccountProviderUpdate(t *testing.T) {
+	var result ExternalAccountProvider
+	err := json.NewDecoder(strings.NewReader(externalAccountProviderBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	result.Authentication.Token = "new-token"
+	result.RemoteRoleMapping = result.RemoteRoleMapping[:1]
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error making Update call: %s", err)
+	}
+
+	expected := `{"Authentication":{"AuthenticationType":"Token","Token":"new-token"},"RemoteRoleMapping":[{"LocalRole":"Administrator","RemoteGroup":"Admin"}]}`
+	if client.calls[0].Payload != expected {
+		t.Errorf("Unexpected update payload: %s", client.calls[0].Payload)
+	}
+
+	err = result.Delete()
+	if err != nil {
+		t.Errorf("Error deleting provider: %s", err)
+	}
+
+	if client.calls[1].Method != http.MethodDelete {
+		t.Errorf("Unexpected delete request: %s", client.calls[1].Method)
+	}
+}
@@ -0,0 +1,188 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// Fabric represents a simple fabric consisting of one or more switches, zero
+// or more endpoints, and zero or more zones.
+type Fabric struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// FabricType shall contain the type of fabric being represented by this
+	// simple fabric.
+	FabricType common.Protocol
+	// MaxZones shall contain the maximum number of zones the switch can
+	// currently configure.
+	MaxZones int
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// addressPools shall contain a link to a resource collection of type
+	// AddressPoolCollection.
+	addressPools string
+	// endpoints shall contain a link to a resource collection of type
+	// EndpointCollection.
+	endpoints string
+	// switches shall contain a link to a resource collection of type
+	// SwitchCollection.
+	switches string
+	// zones shall contain a link to a resource collection of type
+	// ZoneCollection.
+	zones string
+}
+
+// UnmarshalJSON unmarshals a Fabric object from the raw JSON.
+func (fabric *Fabric) UnmarshalJSON(b []byte) error {
+	type temp Fabric
+	var t struct {
+		temp
+		AddressPools common.Link
+		Endpoints    common.Link
+		Switches     common.Link
+		Zones        common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*fabric = Fabric(t.temp)
+
+	// Extract the links to other entities for later
+	fabric.addressPools = string(t.AddressPools)
+	fabric.endpoints = string(t.Endpoints)
+	fabric.switches = string(t.Switches)
+	fabric.zones = string(t.Zones)
+
+	return nil
+}
+
+// GetFabric will get a Fabric instance from the service.
+func GetFabric(c common.Client, uri string) (*Fabric, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var fabric Fabric
+	err = json.NewDecoder(resp.Body).Decode(&fabric)
+	if err != nil {
+		return nil, err
+	}
+
+	fabric.SetClient(c)
+	return &fabric, nil
+}
+
+// ListReferencedFabrics gets the collection of Fabric from
+// a provided reference.
+func ListReferencedFabrics(c common.Client, link string) ([]*Fabric, error) {
+	var result []*Fabric
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, fabricLink := range links.ItemLinks {
+		fabric, err := GetFabric(c, fabricLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, fabric)
+	}
+
+	return result, nil
+}
+
+// AddressPools gets the address pools of the fabric.
+func (fabric *Fabric) AddressPools() ([]*AddressPool, error) {
+	return ListReferencedAddressPools(fabric.Client, fabric.addressPools)
+}
+
+// Endpoints gets the endpoints of the fabric.
+func (fabric *Fabric) Endpoints() ([]*Endpoint, error) {
+	return ListReferencedEndpoints(fabric.Client, fabric.endpoints)
+}
+
+// Switches gets the switches of the fabric.
+func (fabric *Fabric) Switches() ([]*Switch, error) {
+	return ListReferencedSwitches(fabric.Client, fabric.switches)
+}
+
+// Zones gets the zones of the fabric.
+func (fabric *Fabric) Zones() ([]*Zone, error) {
+	return ListReferencedZones(fabric.Client, fabric.zones)
+}
+
+// CreateZone creates a new zone in the fabric containing the given
+// endpoints. The name, description and zone type are taken from the zone.
+func (fabric *Fabric) CreateZone(zone *Zone, endpoints []*Endpoint) (*Zone, error) {
+	if fabric.zones == "" {
+		return nil, fmt.Errorf("fabric does not support zones")
+	}
+
+	t := struct {
+		Name        string   `json:",omitempty"`
+		Description string   `json:",omitempty"`
+		ZoneType    ZoneType `json:",omitempty"`
+		Links       struct {
+			Endpoints common.Links
+		}
+	}{
+		Name:        zone.Name,
+		Description: zone.Description,
+		ZoneType:    zone.ZoneType,
+	}
+	t.Links.Endpoints = endpointLinks(endpoints)
+
+	resp, err := fabric.Post(fabric.zones, t)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if location := resp.Header.Get("Location"); location != "" {
+		return GetZone(fabric.Client, location)
+	}
+
+	var created Zone
+	err = json.NewDecoder(resp.Body).Decode(&created)
+	if err != nil {
+		return nil, err
+	}
+
+	created.SetClient(fabric.Client)
+	return &created, nil
+}
+
+// endpointLinks gets the links to the given endpoints.
+func endpointLinks(endpoints []*Endpoint) common.Links {
+	links := common.Links{}
+	for _, endpoint := range endpoints {
+		links = append(links, common.Link(endpoint.ODataID))
+	}
+	return links
+}
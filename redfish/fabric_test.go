@@ -0,0 +1,120 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+var fabricBody = `{
+		"@odata.context": "/redfish/v1/$metadata#Fabric.Fabric",
+		"@odata.type": "#Fabric.v1_1_0.Fabric",
+		"@odata.id": "/redfish/v1/Fabrics/NVMeoF",
+		"Id": "NVMeoF",
+		"Name": "NVMe-oF Fabric",
+		"FabricType": "NVMeOverFabrics",
+		"MaxZones": 32,
+		"Status": {
+			"State": "Enabled",
+			"Health": "OK"
+		},
+		"AddressPools": {
+			"@odata.id": "/redfish/v1/Fabrics/NVMeoF/AddressPools"
+		},
+		"Endpoints": {
+			"@odata.id": "/redfish/v1/Fabrics/NVMeoF/Endpoints"
+		},
+		"Switches": {
+			"@odata.id": "/redfish/v1/Fabrics/NVMeoF/Switches"
+		},
+		"Zones": {
+			"@odata.id": "/redfish/v1/Fabrics/NVMeoF/Zones"
+		}
+	}`
+
+// TestFabric tests the parsing of Fabric objects.
+func TestFabric(t *testing.T) {
+	var result Fabric
+	err := json.NewDecoder(strings.NewReader(fabricBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "NVMeoF" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.FabricType != common.NVMeOverFabricsProtocol {
+		t.Errorf("Invalid fabric type: %s", result.FabricType)
+	}
+
+	if result.MaxZones != 32 {
+		t.Errorf("Invalid max zones: %d", result.MaxZones)
+	}
+
+	if result.addressPools != "/redfish/v1/Fabrics/NVMeoF/AddressPools" {
+		t.Errorf("Invalid address pools link: %s", result.addressPools)
+	}
+
+	if result.endpoints != "/redfish/v1/Fabrics/NVMeoF/Endpoints" {
+		t.Errorf("Invalid endpoints link: %s", result.endpoints)
+	}
+
+	if result.switches != "/redfish/v1/Fabrics/NVMeoF/Switches" {
+		t.Errorf("Invalid switches link: %s", result.switches)
+	}
+
+	if result.zones != "/redfish/v1/Fabrics/NVMeoF/Zones" {
+		t.Errorf("Invalid zones link: %s", result.zones)
+	}
+}
+
+// TestFabricCreateZone tests creating a zone of endpoints.
+func TestFabricCreateZone(t *testing.T) {
+	var result Fabric
+	err := json.NewDecoder(strings.NewReader(fabricBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/Fabrics/NVMeoF/Zones/1": zoneBody,
+		},
+		headers: map[string]http.Header{
+			"POST /redfish/v1/Fabrics/NVMeoF/Zones": {
+				"Location": []string{"/redfish/v1/Fabrics/NVMeoF/Zones/1"},
+			},
+		},
+	}
+	result.SetClient(client)
+
+	endpoints := []*Endpoint{
+		{ODataID: "/redfish/v1/Fabrics/NVMeoF/Endpoints/Initiator1"},
+		{ODataID: "/redfish/v1/Fabrics/NVMeoF/Endpoints/Target1"},
+	}
+	zone, err := result.CreateZone(&Zone{
+		Entity:   common.Entity{Name: "Host 1 storage"},
+		ZoneType: ZoneOfEndpointsZoneType,
+	}, endpoints)
+	if err != nil {
+		t.Fatalf("Error creating zone: %s", err)
+	}
+
+	if zone.ID != "1" {
+		t.Errorf("Invalid created zone: %s", zone.ID)
+	}
+
+	expected := `{"Name":"Host 1 storage","ZoneType":"ZoneOfEndpoints","Links":{"Endpoints":[{"@odata.id":"/redfish/v1/Fabrics/NVMeoF/Endpoints/Initiator1"},{"@odata.id":"/redfish/v1/Fabrics/NVMeoF/Endpoints/Target1"}]}}`
+	if client.calls[0].Payload != expected {
+		t.Errorf("Unexpected create payload: %s", client.calls[0].Payload)
+	}
+}
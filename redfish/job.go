@@ -0,0 +1,228 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// JobState is the state of a job.
+type JobState string
+
+const (
+	// NewJobState shall represent that this job is newly created but the
+	// operation has not yet started.
+	NewJobState JobState = "New"
+	// StartingJobState shall represent that the operation is starting.
+	StartingJobState JobState = "Starting"
+	// RunningJobState shall represent that the operation is executing.
+	RunningJobState JobState = "Running"
+	// SuspendedJobState shall represent that the operation has been
+	// suspended but is expected to restart and is therefore not complete.
+	SuspendedJobState JobState = "Suspended"
+	// InterruptedJobState shall represent that the operation has been
+	// interrupted but is expected to restart and is therefore not complete.
+	InterruptedJobState JobState = "Interrupted"
+	// PendingJobState shall represent that the operation is pending some
+	// condition and has not yet begun to execute.
+	PendingJobState JobState = "Pending"
+	// StoppingJobState shall represent that the operation is stopping but is
+	// not yet complete.
+	StoppingJobState JobState = "Stopping"
+	// CompletedJobState shall represent that the operation completed
+	// successfully or with warnings.
+	CompletedJobState JobState = "Completed"
+	// CancelledJobState shall represent that the operation completed because
+	// the job was cancelled by an operator.
+	CancelledJobState JobState = "Cancelled"
+	// ExceptionJobState shall represent that the operation completed with
+	// errors.
+	ExceptionJobState JobState = "Exception"
+	// ServiceJobState shall represent that the operation is now running as a
+	// service and expected to continue operation until stopped or killed.
+	ServiceJobState JobState = "Service"
+	// UserInterventionJobState shall represent that the operation is waiting
+	// for a user to intervene and needs to be manually continued, stopped, or
+	// cancelled.
+	UserInterventionJobState JobState = "UserIntervention"
+	// ContinueJobState shall represent that the operation has been resumed
+	// from a paused condition and should return to a Running state.
+	ContinueJobState JobState = "Continue"
+)
+
+// JobPayload shall contain information detailing the HTTP and JSON payload
+// information for executing this job.
+type JobPayload struct {
+	// HTTPHeaders shall contain an array of HTTP headers in this job.
+	HTTPHeaders []string `json:"HttpHeaders,omitempty"`
+	// HTTPOperation shall contain the HTTP operation that executes this job.
+	HTTPOperation string `json:"HttpOperation,omitempty"`
+	// JSONBody shall contain JSON-formatted payload for this job.
+	JSONBody string `json:"JsonBody,omitempty"`
+	// TargetURI shall contain the link to the target for this job.
+	TargetURI string `json:"TargetUri,omitempty"`
+}
+
+// Job shall contain a job in a Redfish implementation.
+type Job struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// CreatedBy shall contain the user name, software program name, or other
+	// identifier indicating the creator of this job.
+	CreatedBy string
+	// Description provides a description of this resource.
+	Description string
+	// EndTime shall indicate the date and time when the job was completed.
+	EndTime string
+	// EstimatedDuration shall indicate the estimated total time needed to
+	// run the job.
+	EstimatedDuration string
+	// HidePayload shall indicate whether the contents of the payload should
+	// be hidden from view after the job has been created.
+	HidePayload bool
+	// JobState shall indicate the state of the job.
+	JobState JobState
+	// JobStatus shall indicate the health status of the job.
+	JobStatus common.Health
+	// MaxExecutionTime shall be an ISO 8601 conformant duration describing
+	// the maximum duration the job is allowed to run.
+	MaxExecutionTime string
+	// Payload shall contain the HTTP and JSON payload information for
+	// executing this job.
+	Payload JobPayload
+	// PercentComplete shall indicate the completion progress of the job,
+	// reported in percent of completion.
+	PercentComplete int
+	// Schedule shall contain the scheduling details for this job and the
+	// recurrence frequency for future instances of this job.
+	Schedule common.Schedule
+	// StartTime shall indicate the date and time when the job was last
+	// started or is scheduled to start.
+	StartTime string
+	// StepOrder shall contain an array of IDs for the job steps in the order
+	// that they shall be executed.
+	StepOrder []string
+	// rawData holds the original serialized JSON so we can compare updates.
+	rawData []byte
+	// steps shall contain a link to a resource collection of type
+	// JobCollection.
+	steps string
+}
+
+// UnmarshalJSON unmarshals a Job object from the raw JSON.
+func (job *Job) UnmarshalJSON(b []byte) error {
+	type temp Job
+	var t struct {
+		temp
+		Steps common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*job = Job(t.temp)
+
+	// Extract the links to other entities for later
+	job.steps = string(t.Steps)
+
+	// This is a read/write object, so we need to save the raw object data for later
+	job.rawData = b
+
+	return nil
+}
+
+// Update commits updates to this object's properties to the running system.
+func (job *Job) Update() error {
+	// Get a representation of the object's original state so we can find what
+	// to update.
+	original := new(Job)
+	err := original.UnmarshalJSON(job.rawData)
+	if err != nil {
+		return err
+	}
+
+	readWriteFields := []string{
+		"JobState",
+		"MaxExecutionTime",
+		"Schedule",
+		"StartTime",
+	}
+
+	originalElement := reflect.ValueOf(original).Elem()
+	currentElement := reflect.ValueOf(job).Elem()
+
+	return job.Entity.Update(job.ODataID, originalElement, currentElement, readWriteFields, &job.rawData)
+}
+
+// Delete removes the job from the service.
+func (job *Job) Delete() error {
+	resp, err := job.Client.Delete(job.ODataID)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// GetJob will get a Job instance from the service.
+func GetJob(c common.Client, uri string) (*Job, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var job Job
+	err = json.NewDecoder(resp.Body).Decode(&job)
+	if err != nil {
+		return nil, err
+	}
+
+	job.SetClient(c)
+	return &job, nil
+}
+
+// ListReferencedJobs gets the collection of Job from
+// a provided reference.
+func ListReferencedJobs(c common.Client, link string) ([]*Job, error) {
+	var result []*Job
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, jobLink := range links.ItemLinks {
+		job, err := GetJob(c, jobLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, job)
+	}
+
+	return result, nil
+}
+
+// Steps gets the steps of this job.
+func (job *Job) Steps() ([]*Job, error) {
+	return ListReferencedJobs(job.Client, job.steps)
+}
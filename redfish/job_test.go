@@ -0,0 +1,127 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+var jobBody = `{
+		"@odata.context": "/redfish/v1/$metadata#Job.Job",
+		"@odata.type": "#Job.v1_0_2.Job",
+		"@odata.id": "/redfish/v1/JobService/Jobs/Nightly",
+		"Id": "Nightly",
+		"Name": "Nightly maintenance",
+		"CreatedBy": "operator",
+		"JobState": "Pending",
+		"JobStatus": "OK",
+		"PercentComplete": 0,
+		"MaxExecutionTime": "PT1H",
+		"StartTime": "2020-06-02T02:00:00Z",
+		"Payload": {
+			"HttpOperation": "POST",
+			"TargetUri": "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset",
+			"JsonBody": "{\"ResetType\": \"GracefulRestart\"}"
+		},
+		"Schedule": {
+			"InitialStartTime": "2020-06-02T02:00:00Z",
+			"RecurrenceInterval": "P1D",
+			"EnabledDaysOfWeek": ["Saturday", "Sunday"]
+		},
+		"StepOrder": ["Drain", "Reset"],
+		"Steps": {
+			"@odata.id": "/redfish/v1/JobService/Jobs/Nightly/Steps"
+		}
+	}`
+
+// TestJob tests the parsing of Job objects.
+func TestJob(t *testing.T) {
+	var result Job
+	err := json.NewDecoder(strings.NewReader(jobBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "Nightly" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.JobState != PendingJobState {
+		t.Errorf("Invalid job state: %s", result.JobState)
+	}
+
+	if result.JobStatus != common.OKHealth {
+		t.Errorf("Invalid job status: %s", result.JobStatus)
+	}
+
+	if result.Payload.TargetURI != "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset" {
+		t.Errorf("Invalid payload target: %s", result.Payload.TargetURI)
+	}
+
+	if result.Schedule.EnabledDaysOfWeek[1] != common.SundayDayOfWeek {
+		t.Errorf("Invalid schedule: %v", result.Schedule.EnabledDaysOfWeek)
+	}
+
+	if result.StepOrder[1] != "Reset" {
+		t.Errorf("Invalid step order: %v", result.StepOrder)
+	}
+
+	if result.steps != "/redfish/v1/JobService/Jobs/Nightly/Steps" {
+		t.Errorf("Invalid steps link: %s", result.steps)
+	}
+}
+
+// TestJobUpdate tests that only changed, writable properties are sent.
+func TestJobUpdate(t *testing.T) {
+	var result Job
+	err := json.NewDecoder(strings.NewReader(jobBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error updating job: %s", err)
+	}
+	if len(client.calls) != 0 {
+		t.Errorf("Expected no request for an unchanged job, got %v", client.calls)
+	}
+
+	result.Schedule.RecurrenceInterval = "P7D"
+	result.CreatedBy = "someone else"
+	err = result.Update()
+	if err != nil {
+		t.Errorf("Error updating job: %s", err)
+	}
+
+	if len(client.calls) != 1 || client.calls[0].Method != http.MethodPatch {
+		t.Fatalf("Expected a single PATCH, got %v", client.calls)
+	}
+
+	payload := client.calls[0].Payload
+	if !strings.Contains(payload, `"RecurrenceInterval":"P7D"`) {
+		t.Errorf("Schedule should be sent: %s", payload)
+	}
+	if strings.Contains(payload, "CreatedBy") || strings.Contains(payload, "MaxExecutionTime") {
+		t.Errorf("Only changed writable properties should be sent: %s", payload)
+	}
+
+	err = result.Delete()
+	if err != nil {
+		t.Errorf("Error deleting job: %s", err)
+	}
+	if client.calls[1].Method != http.MethodDelete || client.calls[1].URL != "/redfish/v1/JobService/Jobs/Nightly" {
+		t.Errorf("Unexpected delete call: %v", client.calls[1])
+	}
+}
@@ -0,0 +1,165 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// JobServiceCapabilities shall contain properties that describe the
+// capabilities or settings of the job service.
+type JobServiceCapabilities struct {
+	// MaxJobs shall contain the maximum number of jobs supported by the
+	// implementation.
+	MaxJobs int
+	// MaxSteps shall contain the maximum number of steps supported by a
+	// single job instance.
+	MaxSteps int
+	// Scheduling shall indicate whether the Schedule property within the
+	// job supports scheduling of jobs.
+	Scheduling bool
+}
+
+// JobService shall represent a job service for a Redfish implementation.
+type JobService struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// DateTime shall contain the current date and time setting for the job
+	// service.
+	DateTime string
+	// Description provides a description of this resource.
+	Description string
+	// ServiceCapabilities shall contain properties that describe the
+	// capabilities or settings of the job service.
+	ServiceCapabilities JobServiceCapabilities
+	// ServiceEnabled shall indicate whether this service is enabled.
+	ServiceEnabled bool
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// jobs shall contain a link to a resource collection of type
+	// JobCollection.
+	jobs string
+	// log shall contain a link to a resource of type LogService that this
+	// job service uses.
+	log string
+}
+
+// UnmarshalJSON unmarshals a JobService object from the raw JSON.
+func (jobservice *JobService) UnmarshalJSON(b []byte) error {
+	type temp JobService
+	var t struct {
+		temp
+		Jobs common.Link
+		Log  common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*jobservice = JobService(t.temp)
+
+	// Extract the links to other entities for later
+	jobservice.jobs = string(t.Jobs)
+	jobservice.log = string(t.Log)
+
+	return nil
+}
+
+// GetJobService will get a JobService instance from the service.
+func GetJobService(c common.Client, uri string) (*JobService, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var jobservice JobService
+	err = json.NewDecoder(resp.Body).Decode(&jobservice)
+	if err != nil {
+		return nil, err
+	}
+
+	jobservice.SetClient(c)
+	return &jobservice, nil
+}
+
+// Jobs gets the jobs of the service.
+func (jobservice *JobService) Jobs() ([]*Job, error) {
+	return ListReferencedJobs(jobservice.Client, jobservice.jobs)
+}
+
+// Log gets the log service used by the job service.
+func (jobservice *JobService) Log() (*LogService, error) {
+	if jobservice.log == "" {
+		return nil, nil
+	}
+
+	return GetLogService(jobservice.Client, jobservice.log)
+}
+
+// CreateJob creates a new job. Only the properties that can be set when a
+// job is created are sent to the service.
+func (jobservice *JobService) CreateJob(job *Job) (*Job, error) {
+	if jobservice.jobs == "" {
+		return nil, fmt.Errorf("job service does not support creating jobs")
+	}
+
+	t := struct {
+		Name             string           `json:",omitempty"`
+		Description      string           `json:",omitempty"`
+		HidePayload      bool             `json:",omitempty"`
+		MaxExecutionTime string           `json:",omitempty"`
+		Payload          *JobPayload      `json:",omitempty"`
+		Schedule         *common.Schedule `json:",omitempty"`
+		StartTime        string           `json:",omitempty"`
+		StepOrder        []string         `json:",omitempty"`
+	}{
+		Name:             job.Name,
+		Description:      job.Description,
+		HidePayload:      job.HidePayload,
+		MaxExecutionTime: job.MaxExecutionTime,
+		StartTime:        job.StartTime,
+		StepOrder:        job.StepOrder,
+	}
+	if !reflect.DeepEqual(job.Payload, JobPayload{}) {
+		t.Payload = &job.Payload
+	}
+	if !reflect.DeepEqual(job.Schedule, common.Schedule{}) {
+		t.Schedule = &job.Schedule
+	}
+
+	resp, err := jobservice.Post(jobservice.jobs, t)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if location := resp.Header.Get("Location"); location != "" {
+		return GetJob(jobservice.Client, location)
+	}
+
+	var created Job
+	err = json.NewDecoder(resp.Body).Decode(&created)
+	if err != nil {
+		return nil, err
+	}
+
+	created.SetClient(jobservice.Client)
+	return &created, nil
+}
@@ -0,0 +1,104 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+var jobServiceBody = `{
+		"@odata.context": "/redfish/v1/$metadata#JobService.JobService",
+		"@odata.type": "#JobService.v1_0_2.JobService",
+		"@odata.id": "/redfish/v1/JobService",
+		"Id": "JobService",
+		"Name": "Job Service",
+		"DateTime": "2020-06-01T12:00:00Z",
+		"ServiceEnabled": true,
+		"ServiceCapabilities": {
+			"MaxJobs": 100,
+			"MaxSteps": 50,
+			"Scheduling": true
+		},
+		"Status": {
+			"State": "Enabled",
+			"Health": "OK"
+		},
+		"Jobs": {
+			"@odata.id": "/redfish/v1/JobService/Jobs"
+		},
+		"Log": {
+			"@odata.id": "/redfish/v1/JobService/Log"
+		}
+	}`
+
+// TestJobService tests the parsing of JobService objects.
+func TestJobService(t *testing.T) {
+	var result JobService
+	err := json.NewDecoder(strings.NewReader(jobServiceBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "JobService" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.ServiceCapabilities.MaxJobs != 100 || !result.ServiceCapabilities.Scheduling {
+		t.Errorf("Invalid service capabilities: %+v", result.ServiceCapabilities)
+	}
+
+	if result.jobs != "/redfish/v1/JobService/Jobs" {
+		t.Errorf("Invalid jobs link: %s", result.jobs)
+	}
+
+	if result.log != "/redfish/v1/JobService/Log" {
+		t.Errorf("Invalid log link: %s", result.log)
+	}
+}
+
+// TestJobServiceCreateJob tests creating a scheduled job.
+func TestJobServiceCreateJob(t *testing.T) {
+	var result JobService
+	err := json.NewDecoder(strings.NewReader(jobServiceBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{
+		responses: map[string]string{
+			"POST /redfish/v1/JobService/Jobs": jobBody,
+		},
+	}
+	result.SetClient(client)
+
+	job, err := result.CreateJob(&Job{
+		Entity: common.Entity{Name: "Nightly maintenance"},
+		Payload: JobPayload{
+			HTTPOperation: "POST",
+			TargetURI:     "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset",
+		},
+		Schedule: common.Schedule{
+			InitialStartTime:   "2020-06-02T02:00:00Z",
+			RecurrenceInterval: "P1D",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating job: %s", err)
+	}
+
+	if job.ID != "Nightly" {
+		t.Errorf("Invalid created job: %s", job.ID)
+	}
+
+	expected := `{"Name":"Nightly maintenance","Payload":{"HttpOperation":"POST","TargetUri":"/redfish/v1/Systems/1/Actions/ComputerSystem.Reset"},"Schedule":{"InitialStartTime":"2020-06-02T02:00:00Z","RecurrenceInterval":"P1D"}}`
+	if client.calls[0].Payload != expected {
+		t.Errorf("Unexpected create payload: %s", client.calls[0].Payload)
+	}
+}
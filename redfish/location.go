@@ -0,0 +1,471 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// Heights of a rack unit in millimeters.
+const (
+	eia310RackUnitMm = 44.45
+	openURackUnitMm  = 48
+	// rackUnitToleranceMm allows chassis slightly taller than a whole
+	// number of units, as manufacturers often round their heights up.
+	rackUnitToleranceMm = 2
+)
+
+// DefaultRackHeightUnits is the height of a rack when the LocationIndex does
+// not set one.
+const DefaultRackHeightUnits = 42
+
+// PlacementIssueKind is the kind of problem found with the placement of
+// resources in a rack.
+type PlacementIssueKind string
+
+const (
+	// OverlapPlacementIssueKind Resources that are not contained in one
+	// another occupy the same rack units.
+	OverlapPlacementIssueKind PlacementIssueKind = "Overlap"
+	// MixedUnitsPlacementIssueKind Resources in the same rack report their
+	// offsets in different rack units.
+	MixedUnitsPlacementIssueKind PlacementIssueKind = "MixedUnits"
+	// MissingOffsetPlacementIssueKind A resource reports its rack but not its
+	// offset in the rack.
+	MissingOffsetPlacementIssueKind PlacementIssueKind = "MissingOffset"
+	// OutOfRangePlacementIssueKind A resource is placed below the bottom or
+	// above the top of the rack.
+	OutOfRangePlacementIssueKind PlacementIssueKind = "OutOfRange"
+)
+
+// PlacementIssue is a problem with the placement of resources in a rack.
+type PlacementIssue struct {
+	// Kind is the kind of problem.
+	Kind PlacementIssueKind
+	// Resources are the locations of the resources with the problem.
+	Resources []string
+	// Message describes the problem.
+	Message string
+}
+
+// LocatedResource is a resource with its physical location.
+type LocatedResource struct {
+	// Resource is the location of the resource in the service.
+	Resource string
+	// Name is the name of the resource.
+	Name string
+	// Type is the type of the resource, such as "Chassis" or "Drive".
+	Type string
+	// Location is the physical location of the resource.
+	Location common.Location
+	// HeightUnits is the number of rack units the resource occupies, or zero
+	// if it is not known, in which case one unit is assumed.
+	HeightUnits int
+
+	// container is the location of the resource this one is contained in.
+	container string
+}
+
+// RackKey identifies a rack by the site, building, room and row it is in.
+type RackKey struct {
+	// Site is the postal address of the rack, as a single line.
+	Site string
+	// Building is the building of the rack.
+	Building string
+	// Room is the room of the rack.
+	Room string
+	// Row is the row of the rack.
+	Row string
+	// Rack is the name of the rack.
+	Rack string
+}
+
+// less orders rack keys from the site down to the rack.
+func (key RackKey) less(other RackKey) bool {
+	a := []string{key.Site, key.Building, key.Room, key.Row, key.Rack}
+	b := []string{other.Site, other.Building, other.Room, other.Row, other.Rack}
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
+
+// RackItem is a resource in a rack elevation.
+type RackItem struct {
+	// Resource is the location of the resource in the service.
+	Resource string
+	// Name is the name of the resource.
+	Name string
+	// Type is the type of the resource.
+	Type string
+	// RackOffset is the lowest rack unit the resource occupies, counted from
+	// the bottom of the rack starting with 0.
+	RackOffset int
+	// HeightUnits is the number of rack units the resource occupies.
+	HeightUnits int
+	// PartLocation is the location of the resource within its rack units,
+	// such as the bay of a drive, if the resource reports one.
+	PartLocation *common.PartLocation `json:",omitempty"`
+}
+
+// RackUnitSlot is a single rack unit of a rack elevation.
+type RackUnitSlot struct {
+	// RackOffset is the offset of the unit from the bottom of the rack,
+	// starting with 0.
+	RackOffset int
+	// Occupants are the resources occupying the unit, empty if it is free.
+	Occupants []string `json:",omitempty"`
+}
+
+// RackElevation is the layout of the resources in a rack, suitable for
+// exporting as JSON.
+type RackElevation struct {
+	RackKey
+
+	// RackOffsetUnits are the rack units the offsets are measured in.
+	RackOffsetUnits common.RackUnits `json:",omitempty"`
+	// HeightUnits is the height of the rack. It is larger than the height of
+	// the index if resources are placed above it.
+	HeightUnits int
+	// FreeUnits is the number of rack units without an occupant.
+	FreeUnits int
+	// Items are the resources placed in the rack, sorted by offset.
+	Items []RackItem
+	// Unplaced are the resources in the rack without a valid offset.
+	Unplaced []RackItem `json:",omitempty"`
+	// Slots has an entry for each rack unit, from the bottom of the rack.
+	Slots []RackUnitSlot
+	// Issues are the problems found with the placement of the resources.
+	Issues []PlacementIssue `json:",omitempty"`
+}
+
+// LocationIndex groups resources by the site, building, room, row and rack
+// they are in, and lays out the resources in each rack. It is not safe for
+// concurrent use.
+type LocationIndex struct {
+	// RackHeightUnits is the height of the racks, DefaultRackHeightUnits if
+	// it is zero.
+	RackHeightUnits int
+
+	resources []*LocatedResource
+}
+
+// NewLocationIndex creates an empty location index.
+func NewLocationIndex() *LocationIndex {
+	return &LocationIndex{}
+}
+
+// Add adds a resource to the index.
+func (index *LocationIndex) Add(resource LocatedResource) {
+	index.resources = append(index.resources, &resource)
+}
+
+// AddChassis adds chassis to the index. Their height in rack units is
+// derived from their HeightMm. Chassis that share rack units with the
+// chassis they are contained in are not reported as overlapping.
+func (index *LocationIndex) AddChassis(chassis ...*Chassis) {
+	for _, c := range chassis {
+		index.resources = append(index.resources, &LocatedResource{
+			Resource:    c.ODataID,
+			Name:        c.Name,
+			Type:        "Chassis",
+			Location:    c.Location,
+			HeightUnits: heightUnits(c.HeightMm, c.Location.Placement.RackOffsetUnits),
+			container:   c.containedBy,
+		})
+	}
+}
+
+// AddDrives adds drives to the index, with their PhysicalLocation, or their
+// first Location if they do not report one. Drives that share rack units
+// with their chassis are not reported as overlapping.
+func (index *LocationIndex) AddDrives(drives ...*Drive) {
+	for _, drive := range drives {
+		location := drive.PhysicalLocation
+		if reflect.DeepEqual(location, common.Location{}) && len(drive.Location) > 0 {
+			location = drive.Location[0]
+		}
+
+		index.resources = append(index.resources, &LocatedResource{
+			Resource:  drive.ODataID,
+			Name:      drive.Name,
+			Type:      "Drive",
+			Location:  location,
+			container: drive.chassis,
+		})
+	}
+}
+
+// Unracked gets the resources that do not report the rack they are in.
+func (index *LocationIndex) Unracked() []*LocatedResource {
+	var result []*LocatedResource
+	for _, resource := range index.resources {
+		if resource.Location.Placement.Rack == "" {
+			result = append(result, resource)
+		}
+	}
+	return result
+}
+
+// Racks gets the elevation of each rack with resources in the index, sorted
+// by site, building, room, row and rack.
+func (index *LocationIndex) Racks() []*RackElevation {
+	racks := make(map[RackKey][]*LocatedResource)
+	for _, resource := range index.resources {
+		if resource.Location.Placement.Rack == "" {
+			continue
+		}
+		key := rackKey(resource.Location)
+		racks[key] = append(racks[key], resource)
+	}
+
+	keys := make([]RackKey, 0, len(racks))
+	for key := range racks {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].less(keys[j])
+	})
+
+	height := index.RackHeightUnits
+	if height <= 0 {
+		height = DefaultRackHeightUnits
+	}
+
+	result := make([]*RackElevation, len(keys))
+	for i, key := range keys {
+		result[i] = index.elevation(key, racks[key], height)
+	}
+	return result
+}
+
+// elevation lays out the resources of a rack.
+func (index *LocationIndex) elevation(key RackKey, resources []*LocatedResource, height int) *RackElevation {
+	elevation := &RackElevation{
+		RackKey:     key,
+		HeightUnits: height,
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].Resource < resources[j].Resource
+	})
+	elevation.RackOffsetUnits = rackOffsetUnits(resources)
+
+	var mixed []string
+	for _, resource := range resources {
+		placement := resource.Location.Placement
+		item := RackItem{
+			Resource:    resource.Resource,
+			Name:        resource.Name,
+			Type:        resource.Type,
+			RackOffset:  placement.RackOffset,
+			HeightUnits: resource.HeightUnits,
+		}
+		if item.HeightUnits <= 0 {
+			item.HeightUnits = 1
+		}
+		if resource.Location.PartLocation != (common.PartLocation{}) {
+			partLocation := resource.Location.PartLocation
+			item.PartLocation = &partLocation
+		}
+
+		switch {
+		case placement.RackOffsetUnits == "":
+			elevation.Unplaced = append(elevation.Unplaced, item)
+			elevation.Issues = append(elevation.Issues, PlacementIssue{
+				Kind:      MissingOffsetPlacementIssueKind,
+				Resources: []string{item.Resource},
+				Message:   fmt.Sprintf("%s is in rack %s but does not report its offset", item.Resource, key.Rack),
+			})
+			continue
+		case item.RackOffset < 0:
+			elevation.Unplaced = append(elevation.Unplaced, item)
+			elevation.Issues = append(elevation.Issues, PlacementIssue{
+				Kind:      OutOfRangePlacementIssueKind,
+				Resources: []string{item.Resource},
+				Message:   fmt.Sprintf("%s is placed below the bottom of rack %s", item.Resource, key.Rack),
+			})
+			continue
+		}
+
+		// The offsets of resources in other units than the rest of the rack
+		// cannot be laid out with them.
+		if placement.RackOffsetUnits != elevation.RackOffsetUnits {
+			mixed = append(mixed, item.Resource)
+			elevation.Unplaced = append(elevation.Unplaced, item)
+			continue
+		}
+
+		if top := item.RackOffset + item.HeightUnits; top > height {
+			elevation.Issues = append(elevation.Issues, PlacementIssue{
+				Kind:      OutOfRangePlacementIssueKind,
+				Resources: []string{item.Resource},
+				Message: fmt.Sprintf("%s extends to unit %d, above the top of rack %s",
+					item.Resource, top-1, key.Rack),
+			})
+			if top > elevation.HeightUnits {
+				elevation.HeightUnits = top
+			}
+		}
+
+		elevation.Items = append(elevation.Items, item)
+	}
+
+	if len(mixed) > 0 {
+		elevation.Issues = append(elevation.Issues, PlacementIssue{
+			Kind:      MixedUnitsPlacementIssueKind,
+			Resources: mixed,
+			Message: fmt.Sprintf("%s report offsets that are not in %s like the rest of rack %s",
+				strings.Join(mixed, ", "), elevation.RackOffsetUnits, key.Rack),
+		})
+	}
+
+	sort.SliceStable(elevation.Items, func(i, j int) bool {
+		return elevation.Items[i].RackOffset < elevation.Items[j].RackOffset
+	})
+
+	elevation.Slots = make([]RackUnitSlot, elevation.HeightUnits)
+	for i := range elevation.Slots {
+		elevation.Slots[i].RackOffset = i
+	}
+	for _, item := range elevation.Items {
+		for offset := item.RackOffset; offset < item.RackOffset+item.HeightUnits; offset++ {
+			elevation.Slots[offset].Occupants = append(elevation.Slots[offset].Occupants, item.Resource)
+		}
+	}
+	for _, slot := range elevation.Slots {
+		if len(slot.Occupants) == 0 {
+			elevation.FreeUnits++
+		}
+	}
+
+	elevation.Issues = append(elevation.Issues, index.overlaps(elevation.Items)...)
+	return elevation
+}
+
+// rackOffsetUnits gets the units most of the resources in a rack report
+// their offsets in. On a tie, the units that reached the count first, in the
+// order of the resources, are used.
+func rackOffsetUnits(resources []*LocatedResource) common.RackUnits {
+	counts := make(map[common.RackUnits]int)
+	var result common.RackUnits
+	for _, resource := range resources {
+		placement := resource.Location.Placement
+		if placement.RackOffsetUnits == "" || placement.RackOffset < 0 {
+			continue
+		}
+
+		counts[placement.RackOffsetUnits]++
+		if counts[placement.RackOffsetUnits] > counts[result] {
+			result = placement.RackOffsetUnits
+		}
+	}
+	return result
+}
+
+// overlaps finds the items sharing rack units that are not contained in
+// one another, and are not at different part locations within the units.
+// The items are sorted by offset.
+func (index *LocationIndex) overlaps(items []RackItem) []PlacementIssue {
+	containers := make(map[string]string, len(index.resources))
+	for _, resource := range index.resources {
+		if resource.container != "" {
+			containers[resource.Resource] = resource.container
+		}
+	}
+
+	var result []PlacementIssue
+	for i, a := range items {
+		for _, b := range items[i+1:] {
+			if b.RackOffset >= a.RackOffset+a.HeightUnits {
+				break
+			}
+			if contained(containers, a.Resource, b.Resource) || contained(containers, b.Resource, a.Resource) {
+				continue
+			}
+			if a.PartLocation != nil && b.PartLocation != nil && *a.PartLocation != *b.PartLocation {
+				continue
+			}
+
+			result = append(result, PlacementIssue{
+				Kind:      OverlapPlacementIssueKind,
+				Resources: []string{a.Resource, b.Resource},
+				Message: fmt.Sprintf("%s and %s both occupy unit %d",
+					a.Resource, b.Resource, b.RackOffset),
+			})
+		}
+	}
+	return result
+}
+
+// contained indicates whether the resource is contained in the container,
+// directly or through other resources, given the container of each
+// resource.
+func contained(containers map[string]string, resource, container string) bool {
+	seen := make(map[string]bool)
+	for current := containers[resource]; current != "" && !seen[current]; current = containers[current] {
+		if current == container {
+			return true
+		}
+		seen[current] = true
+	}
+	return false
+}
+
+// rackKey gets the key of the rack of the location.
+func rackKey(location common.Location) RackKey {
+	address := location.PostalAddress
+
+	var site []string
+	street := address.Street
+	if street == "" {
+		street = address.Road
+	}
+	if address.HouseNumber != 0 {
+		street = strings.TrimSpace(strconv.Itoa(address.HouseNumber) + address.HouseNumberSuffix + " " + street)
+	}
+	for _, part := range []string{address.Name, street, address.City, address.Territory, address.PostalCode} {
+		if part != "" {
+			site = append(site, part)
+		}
+	}
+
+	return RackKey{
+		Site:     strings.Join(site, ", "),
+		Building: address.Building,
+		Room:     address.Room,
+		Row:      location.Placement.Row,
+		Rack:     location.Placement.Rack,
+	}
+}
+
+// heightUnits gets the number of rack units of the given height, or zero
+// if the height is not known.
+func heightUnits(heightMm *float32, units common.RackUnits) int {
+	height, ok := common.Float32Value(heightMm)
+	if !ok || height <= 0 {
+		return 0
+	}
+
+	unitMm := eia310RackUnitMm
+	if units == common.OpenURackUnits {
+		unitMm = openURackUnitMm
+	}
+
+	result := int(math.Ceil((float64(height) - rackUnitToleranceMm) / unitMm))
+	if result < 1 {
+		result = 1
+	}
+	return result
+}
@@ -0,0 +1,200 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// locatedChassis decodes a chassis in the given rack.
+func locatedChassis(t *testing.T, id, rack, placement, extra string) *Chassis {
+	var chassis Chassis
+	err := json.Unmarshal([]byte(fmt.Sprintf(`{
+		"@odata.id": "/redfish/v1/Chassis/%s",
+		"Id": "%s",
+		"Name": "%s",
+		"Location": {
+			"PostalAddress": {
+				"Name": "East Campus",
+				"HouseNumber": 100,
+				"Street": "Main Street",
+				"City": "Springfield",
+				"Building": "B1",
+				"Room": "DC1"
+			},
+			"Placement": {"Row": "A", "Rack": "%s"%s}
+		}%s
+	}`, id, id, id, rack, placement, extra)), &chassis)
+	if err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+	return &chassis
+}
+
+// TestLocationIndexRacks tests laying out chassis in rack elevations.
+func TestLocationIndexRacks(t *testing.T) {
+	eia := func(offset int) string {
+		return fmt.Sprintf(`, "RackOffset": %d, "RackOffsetUnits": "EIA_310"`, offset)
+	}
+
+	index := NewLocationIndex()
+	index.AddChassis(
+		locatedChassis(t, "Enclosure", "R1", eia(10), `, "HeightMm": 440`),
+		locatedChassis(t, "Blade", "R1", eia(10),
+			`, "Links": {"ContainedBy": {"@odata.id": "/redfish/v1/Chassis/Enclosure"}}`),
+		locatedChassis(t, "Server1", "R1", eia(0), `, "HeightMm": 87.9`),
+		locatedChassis(t, "Server2", "R1", eia(1), `, "HeightMm": 43`),
+		locatedChassis(t, "Server3", "R1", "", ""),
+		locatedChassis(t, "Server4", "R1", eia(41), `, "HeightMm": 88.9`),
+		locatedChassis(t, "Server5", "R1", `, "RackOffset": 30, "RackOffsetUnits": "OpenU"`, ""),
+		locatedChassis(t, "Switch", "R0", eia(41), ""),
+		locatedChassis(t, "Spare", "", "", ""),
+	)
+
+	racks := index.Racks()
+	if len(racks) != 2 || racks[0].Rack != "R0" || racks[1].Rack != "R1" {
+		t.Fatalf("Unexpected racks: %+v", racks)
+	}
+
+	if racks[1].Site != "East Campus, 100 Main Street, Springfield" || racks[1].Building != "B1" ||
+		racks[1].Room != "DC1" || racks[1].Row != "A" {
+		t.Errorf("Unexpected rack key: %+v", racks[1].RackKey)
+	}
+
+	r0 := racks[0]
+	if r0.HeightUnits != DefaultRackHeightUnits || r0.FreeUnits != 41 || len(r0.Issues) != 0 {
+		t.Errorf("Unexpected rack R0: %+v", r0)
+	}
+
+	r1 := racks[1]
+	var items []string
+	for _, item := range r1.Items {
+		items = append(items, fmt.Sprintf("%s@%d+%d", item.Name, item.RackOffset, item.HeightUnits))
+	}
+	expected := "Server1@0+2 Server2@1+1 Blade@10+1 Enclosure@10+10 Server4@41+2"
+	if strings.Join(items, " ") != expected {
+		t.Errorf("Unexpected items: %s", strings.Join(items, " "))
+	}
+
+	// Server5 is not laid out, as its offset is in other units.
+	if len(r1.Unplaced) != 2 || r1.Unplaced[0].Name != "Server3" || r1.Unplaced[1].Name != "Server5" {
+		t.Errorf("Unexpected unplaced items: %+v", r1.Unplaced)
+	}
+
+	// Server4 extends the rack to 43 units, of which 14 are occupied.
+	if r1.HeightUnits != 43 || len(r1.Slots) != 43 || r1.FreeUnits != 29 {
+		t.Errorf("Unexpected rack size: %d units, %d slots, %d free", r1.HeightUnits, len(r1.Slots), r1.FreeUnits)
+	}
+	if len(r1.Slots[1].Occupants) != 2 || len(r1.Slots[10].Occupants) != 2 || len(r1.Slots[2].Occupants) != 0 {
+		t.Errorf("Unexpected slots: %+v", r1.Slots[:11])
+	}
+
+	var issues []string
+	for _, issue := range r1.Issues {
+		issues = append(issues, fmt.Sprintf("%s:%s", issue.Kind, strings.Join(issue.Resources, ",")))
+	}
+	expectedIssues := strings.Join([]string{
+		"MissingOffset:/redfish/v1/Chassis/Server3",
+		"OutOfRange:/redfish/v1/Chassis/Server4",
+		"MixedUnits:/redfish/v1/Chassis/Server5",
+		"Overlap:/redfish/v1/Chassis/Server1,/redfish/v1/Chassis/Server2",
+	}, " ")
+	if strings.Join(issues, " ") != expectedIssues {
+		t.Errorf("Unexpected issues: %s", strings.Join(issues, " "))
+	}
+
+	unracked := index.Unracked()
+	if len(unracked) != 1 || unracked[0].Name != "Spare" {
+		t.Errorf("Unexpected unracked resources: %+v", unracked)
+	}
+
+	data, err := json.Marshal(r0)
+	if err != nil {
+		t.Fatalf("Error encoding elevation: %s", err)
+	}
+	if !strings.Contains(string(data), `"Rack":"R0"`) ||
+		!strings.Contains(string(data), `{"RackOffset":41,"Occupants":["/redfish/v1/Chassis/Switch"]}`) {
+		t.Errorf("Unexpected elevation JSON: %s", data)
+	}
+}
+
+// TestLocationIndexMajorityUnits tests that a rack uses the offset units of
+// most of its resources.
+func TestLocationIndexMajorityUnits(t *testing.T) {
+	eia := func(offset int) string {
+		return fmt.Sprintf(`, "RackOffset": %d, "RackOffsetUnits": "EIA_310"`, offset)
+	}
+
+	index := NewLocationIndex()
+	index.AddChassis(
+		locatedChassis(t, "A", "R1", `, "RackOffset": 5, "RackOffsetUnits": "OpenU"`, ""),
+		locatedChassis(t, "B", "R1", eia(0), ""),
+		locatedChassis(t, "C", "R1", eia(1), ""),
+	)
+
+	racks := index.Racks()
+	if len(racks) != 1 || racks[0].RackOffsetUnits != common.EIA310RackUnits {
+		t.Fatalf("Unexpected racks: %+v", racks)
+	}
+
+	if len(racks[0].Items) != 2 || len(racks[0].Unplaced) != 1 || racks[0].Unplaced[0].Name != "A" {
+		t.Errorf("Unexpected layout: %+v", racks[0])
+	}
+}
+
+// TestLocationIndexDrives tests laying out drives in the bays of their
+// chassis.
+func TestLocationIndexDrives(t *testing.T) {
+	placement := `, "RackOffset": 4, "RackOffsetUnits": "EIA_310"`
+
+	drive := func(id string, bay int) *Drive {
+		var drive Drive
+		err := json.Unmarshal([]byte(fmt.Sprintf(`{
+			"@odata.id": "/redfish/v1/Chassis/Server/Drives/%s",
+			"Name": "%s",
+			"PhysicalLocation": {
+				"Placement": {"Row": "A", "Rack": "R1"%s},
+				"PartLocation": {"LocationType": "Bay", "LocationOrdinalValue": %d}
+			},
+			"Links": {"Chassis": {"@odata.id": "/redfish/v1/Chassis/Server"}}
+		}`, id, id, placement, bay)), &drive)
+		if err != nil {
+			t.Fatalf("Error decoding JSON: %s", err)
+		}
+		return &drive
+	}
+
+	server := locatedChassis(t, "Server", "R1", placement, "")
+	server.Location.PostalAddress = common.PostalAddress{}
+
+	index := NewLocationIndex()
+	index.AddChassis(server)
+	index.AddDrives(drive("0", 0), drive("1", 1), drive("2", 1))
+
+	racks := index.Racks()
+	if len(racks) != 1 || len(racks[0].Items) != 4 {
+		t.Fatalf("Unexpected racks: %+v", racks)
+	}
+
+	for _, item := range racks[0].Items {
+		if item.Type == "Drive" && (item.PartLocation == nil || item.PartLocation.LocationType != common.BayLocationType) {
+			t.Errorf("Drive %s should report its bay: %+v", item.Name, item.PartLocation)
+		}
+	}
+
+	// Only the drives in the same bay overlap.
+	var issues []string
+	for _, issue := range racks[0].Issues {
+		issues = append(issues, fmt.Sprintf("%s:%s", issue.Kind, strings.Join(issue.Resources, ",")))
+	}
+	if strings.Join(issues, " ") != "Overlap:/redfish/v1/Chassis/Server/Drives/1,/redfish/v1/Chassis/Server/Drives/2" {
+		t.Errorf("Unexpected issues: %s", strings.Join(issues, " "))
+	}
+}
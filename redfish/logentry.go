@@ -6,6 +6,8 @@ package redfish
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 
 	"github.com/rocksolidlabs/gofish/common"
 )
@@ -317,10 +319,19 @@ type LogEntry struct {
 	ODataID string `json:"@odata.id"`
 	// ODataType is the odata type.
 	ODataType string `json:"@odata.type"`
+	// AdditionalDataSizeBytes shall contain the size of the additional data
+	// referenced by the AdditionalDataURI property for the log entry.
+	AdditionalDataSizeBytes int64
+	// AdditionalDataURI shall contain the URI at which to access the
+	// additional data for the log entry, such as diagnostic data.
+	AdditionalDataURI string
 	// Created shall be the time at which the log entry was created.
 	Created string
 	// Description provides a description of this resource.
 	Description string
+	// DiagnosticDataType shall contain the type of data available in the
+	// AdditionalDataURI property.
+	DiagnosticDataType DiagnosticDataType
 	// EntryCode shall be present if the EntryType value is
 	// SEL. These enumerations are the values from tables 42-1 and 42-2 of
 	// the IPMI specification.
@@ -359,6 +370,9 @@ type LogEntry struct {
 	// the second byte in the string, and Event Data 3 is the third byte in
 	// the string.
 	MessageID string `json:"MessageId"`
+	// OEMDiagnosticDataType shall contain the OEM-defined type of data
+	// available in the AdditionalDataURI property.
+	OEMDiagnosticDataType string
 	// OemLogEntryCode shall represent the OEM
 	// specific Log Entry Code type of the Entry. This property shall only
 	// be present if the value of EntryType is SEL and the value of
@@ -453,3 +467,29 @@ func ListReferencedLogEntrys(c common.Client, link string) ([]*LogEntry, error)
 
 	return result, nil
 }
+
+// AdditionalData gets the additional data of the log entry, such as
+// collected diagnostic data, which is usually not JSON. The data is streamed
+// from the service and the caller must close it.
+func (logentry *LogEntry) AdditionalData() (io.ReadCloser, error) {
+	if logentry.AdditionalDataURI == "" {
+		return nil, fmt.Errorf("log entry %s has no additional data", logentry.ID)
+	}
+
+	resp, err := logentry.Client.GetRaw(logentry.AdditionalDataURI)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// OriginOfCondition gets the resource the log entry is about. Use Typed on
+// the result to get the resource as its specific type.
+func (logentry *LogEntry) OriginOfCondition() (*Resource, error) {
+	if logentry.originOfCondition == "" {
+		return nil, fmt.Errorf("log entry %s has no origin of condition", logentry.ID)
+	}
+
+	return GetResource(logentry.Client, logentry.originOfCondition)
+}
@@ -68,3 +68,60 @@ func TestLogEntry(t *testing.T) {
 		t.Errorf("Received log severity %s", result.Severity)
 	}
 }
+
+// TestLogEntryOriginOfCondition tests getting the origin of a log entry.
+func TestLogEntryOriginOfCondition(t *testing.T) {
+	var result LogEntry
+	err := json.NewDecoder(strings.NewReader(`{
+		"@odata.id": "/redfish/v1/Systems/1/LogServices/SEL/Entries/4",
+		"Id": "4",
+		"EntryType": "SEL",
+		"Links": {
+			"OriginOfCondition": {
+				"@odata.id": "/redfish/v1/Chassis/1/Thermal#/Fans/0"
+			}
+		}
+	}`)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	testClient := &testClient{responses: map[string]string{
+		"GET /redfish/v1/Chassis/1/Thermal": `{
+			"@odata.id": "/redfish/v1/Chassis/1/Thermal",
+			"@odata.type": "#Thermal.v1_5_0.Thermal",
+			"Id": "Thermal",
+			"Fans": [{"Name": "Fan 1", "Reading": 0}]
+		}`,
+	}}
+	result.SetClient(testClient)
+
+	origin, err := result.OriginOfCondition()
+	if err != nil {
+		t.Fatalf("Error getting origin of condition: %s", err)
+	}
+
+	if origin.ResourceType() != "Thermal" || origin.Fragment != "/Fans/0" {
+		t.Errorf("Unexpected origin: %s %s", origin.ResourceType(), origin.Fragment)
+	}
+
+	typed, err := origin.Typed()
+	if err != nil {
+		t.Errorf("Error getting typed origin: %s", err)
+	}
+
+	thermal, ok := typed.(*Thermal)
+	if !ok {
+		t.Fatalf("Expected *Thermal, got %T", typed)
+	}
+
+	if len(thermal.Fans) != 1 || thermal.Client != testClient {
+		t.Errorf("Unexpected thermal resource: %v", thermal.Fans)
+	}
+
+	origin.ODataType = "#Oem.v1_0_0.Widget"
+	typed, _ = origin.Typed()
+	if typed != origin {
+		t.Errorf("Unknown types should be returned as is, got %T", typed)
+	}
+}
@@ -0,0 +1,564 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// defaultLogPageSize is the number of entries requested per page when the
+// service supports the $top and $skip query parameters.
+const defaultLogPageSize = 100
+
+// LogReset is the kind of change found when the last entry seen by a
+// LogFollower is no longer in the log.
+type LogReset string
+
+const (
+	// ClearedLogReset indicates the log was cleared, so all entries in it
+	// are new.
+	ClearedLogReset LogReset = "Cleared"
+	// WrappedLogReset indicates the log wrapped around and overwrote the
+	// last entry seen. Entries written in between may have been lost.
+	WrappedLogReset LogReset = "Wrapped"
+)
+
+// LogPosition is the last log entry seen by a LogFollower.
+type LogPosition struct {
+	// ID is the Id of the entry.
+	ID string
+	// Created is the time the entry was created.
+	Created string
+	// ODataID is the location of the entry.
+	ODataID string
+	// Index is the position of the entry in the entry collection, or -1 if
+	// it is not known.
+	Index int
+}
+
+// LogStateStore persists the positions of log followers, so polls only
+// fetch new entries and restarts do not send entries again.
+type LogStateStore interface {
+	// LoadLogPosition gets the saved position for the key, or nil if there
+	// is none.
+	LoadLogPosition(key string) (*LogPosition, error)
+	// SaveLogPosition saves the position for the key.
+	SaveLogPosition(key string, position *LogPosition) error
+}
+
+// MemoryLogStateStore is a LogStateStore that keeps positions in memory. It
+// is safe for concurrent use.
+type MemoryLogStateStore struct {
+	mutex     sync.Mutex
+	positions map[string]LogPosition
+}
+
+// NewMemoryLogStateStore creates an empty MemoryLogStateStore.
+func NewMemoryLogStateStore() *MemoryLogStateStore {
+	return &MemoryLogStateStore{positions: make(map[string]LogPosition)}
+}
+
+// LoadLogPosition gets the saved position for the key.
+func (store *MemoryLogStateStore) LoadLogPosition(key string) (*LogPosition, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	position, ok := store.positions[key]
+	if !ok {
+		return nil, nil
+	}
+	return &position, nil
+}
+
+// SaveLogPosition saves the position for the key.
+func (store *MemoryLogStateStore) SaveLogPosition(key string, position *LogPosition) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.positions[key] = *position
+	return nil
+}
+
+// FileLogStateStore is a LogStateStore that keeps positions in a JSON file.
+// The file is replaced on each save, so a crash leaves either the old or the
+// new positions. It is safe for concurrent use within a process.
+type FileLogStateStore struct {
+	// Path is the location of the file.
+	Path string
+
+	mutex sync.Mutex
+}
+
+// LoadLogPosition gets the saved position for the key.
+func (store *FileLogStateStore) LoadLogPosition(key string) (*LogPosition, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	positions, err := store.read()
+	if err != nil {
+		return nil, err
+	}
+
+	position, ok := positions[key]
+	if !ok {
+		return nil, nil
+	}
+	return &position, nil
+}
+
+// SaveLogPosition saves the position for the key.
+func (store *FileLogStateStore) SaveLogPosition(key string, position *LogPosition) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	positions, err := store.read()
+	if err != nil {
+		return err
+	}
+	positions[key] = *position
+
+	data, err := json.MarshalIndent(positions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(store.Path), filepath.Base(store.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), store.Path)
+}
+
+// read loads all positions from the file. A missing file has no positions.
+func (store *FileLogStateStore) read() (map[string]LogPosition, error) {
+	positions := make(map[string]LogPosition)
+
+	data, err := ioutil.ReadFile(store.Path)
+	if os.IsNotExist(err) {
+		return positions, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(data, &positions)
+	if err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+// LogPollResult is the outcome of a single LogFollower poll.
+type LogPollResult struct {
+	// Entries is the number of new entries passed to the handler.
+	Entries int
+	// Reset is set if the log was cleared or wrapped since the last poll.
+	Reset LogReset
+}
+
+// LogFollower fetches the entries added to a LogService since it last
+// looked, remembering the last entry seen in a LogStateStore.
+type LogFollower struct {
+	// Key identifies the log in the store. It defaults to the location of
+	// the LogService, and should include the service endpoint when a store
+	// is shared between services.
+	Key string
+	// FilterQuery enables the use of the $filter query parameter to only
+	// list entries created since the last poll.
+	FilterQuery bool
+	// TopSkipQuery enables the use of the $top and $skip query parameters
+	// to list entries in pages, and to skip entries already seen.
+	TopSkipQuery bool
+	// PageSize is the number of entries requested per page when
+	// TopSkipQuery is enabled. The default is 100.
+	PageSize int
+	// ResetHandler is called, if set, when the log was cleared or wrapped
+	// since the last poll, before any new entries are handled.
+	ResetHandler func(LogReset)
+
+	logservice *LogService
+	store      LogStateStore
+	// orderKnown is set once the order of the entry collection has been
+	// found, and newestFirst is set if it lists the newest entries first.
+	orderKnown  bool
+	newestFirst bool
+}
+
+// NewLogFollower creates a follower for the entries of the log service. The
+// query parameters are not used unless enabled, see
+// ProtocolFeaturesSupported of the service root.
+func NewLogFollower(logservice *LogService, store LogStateStore) *LogFollower {
+	return &LogFollower{
+		Key:        logservice.ODataID,
+		logservice: logservice,
+		store:      store,
+	}
+}
+
+// Poll passes the entries added since the last poll to the handler, oldest
+// first. The first poll passes all entries. The position is saved once the
+// entries are handled, or when the handler returns an error, in which case
+// the poll stops and the next poll starts with the failed entry.
+func (follower *LogFollower) Poll(handler func(*LogEntry) error) (*LogPollResult, error) {
+	result := &LogPollResult{}
+
+	position, err := follower.store.LoadLogPosition(follower.Key)
+	if err != nil {
+		return result, err
+	}
+
+	links, base, partial, err := follower.candidateLinks(position)
+	if err != nil {
+		return result, err
+	}
+	links, base, err = follower.oldestFirst(links, base)
+	if err != nil {
+		return result, err
+	}
+
+	start := 0
+	var seen *LogPosition
+	if position != nil {
+		start, err = follower.resume(position, links)
+		if err != nil {
+			return result, err
+		}
+
+		// A filtered or skipped listing may leave out the last entry seen,
+		// or new entries before it, so look again at the whole log.
+		if start < 0 && partial {
+			links, err = follower.entryLinks("", 0)
+			if err != nil {
+				return result, err
+			}
+			links, base, err = follower.oldestFirst(links, 0)
+			if err != nil {
+				return result, err
+			}
+			start, err = follower.resume(position, links)
+			if err != nil {
+				return result, err
+			}
+		}
+
+		if start < 0 {
+			start = 0
+			result.Reset, err = follower.classifyReset(position)
+			if err != nil {
+				return result, err
+			}
+			if follower.ResetHandler != nil {
+				follower.ResetHandler(result.Reset)
+			}
+
+			// Record the reset even if no entry is handled, so it is not
+			// reported again. An empty position marks an empty log.
+			seen = &LogPosition{Index: -1}
+		}
+	}
+
+	for i := start; i < len(links); i++ {
+		entry, err := GetLogEntry(follower.logservice.Client, links[i])
+		if err != nil {
+			return result, follower.save(seen, err)
+		}
+
+		// After wrapping, entries seen before may remain in the log. They
+		// are skipped, but still recorded as seen.
+		if result.Reset != WrappedLogReset || createdAfter(entry.Created, position.Created) {
+			err = handler(entry)
+			if err != nil {
+				return result, follower.save(seen, err)
+			}
+			result.Entries++
+		}
+
+		index := -1
+		if base >= 0 {
+			index = base + i
+		}
+		seen = &LogPosition{
+			ID:      entry.ID,
+			Created: entry.Created,
+			ODataID: entry.ODataID,
+			Index:   index,
+		}
+	}
+
+	return result, follower.save(seen, nil)
+}
+
+// save saves the position of the last entry seen in a poll, if any, and
+// returns the error that ended the poll, or else any error saving it.
+func (follower *LogFollower) save(position *LogPosition, err error) error {
+	if position == nil {
+		return err
+	}
+
+	saveErr := follower.store.SaveLogPosition(follower.Key, position)
+	if err != nil {
+		return err
+	}
+	return saveErr
+}
+
+// Follow polls the log at the given interval until the stop channel is
+// closed or a poll fails.
+func (follower *LogFollower) Follow(interval time.Duration, stop <-chan struct{}, handler func(*LogEntry) error) error {
+	for {
+		_, err := follower.Poll(handler)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-stop:
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// candidateLinks lists the entries that may be new, along with the index of
+// the first of them in the entry collection, or -1 if it is not known, and
+// whether the list is only part of the log. When there is a position the
+// list starts with the last entry seen, if it is still in the log.
+func (follower *LogFollower) candidateLinks(position *LogPosition) ([]string, int, bool, error) {
+	if position == nil {
+		links, err := follower.entryLinks("", 0)
+		return links, 0, false, err
+	}
+
+	if follower.FilterQuery && position.Created != "" {
+		links, err := follower.entryLinks(fmt.Sprintf("Created ge '%s'", position.Created), 0)
+		return links, -1, true, err
+	}
+
+	if follower.TopSkipQuery && position.Index >= 0 {
+		links, err := follower.entryLinks("", position.Index)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		if len(links) > 0 && links[0] == position.ODataID {
+			return links, position.Index, position.Index > 0, nil
+		}
+		// Entries have moved, so fall back to listing all of them.
+	}
+
+	links, err := follower.entryLinks("", 0)
+	return links, 0, false, err
+}
+
+// oldestFirst puts the candidate links in the order the entries were
+// created. Services list entries oldest or newest first, so the order is
+// found from the first and last entries the first time there are two. The
+// index of the first link is not known once the links are reversed.
+func (follower *LogFollower) oldestFirst(links []string, base int) ([]string, int, error) {
+	if !follower.orderKnown && len(links) > 1 {
+		first, err := GetLogEntry(follower.logservice.Client, links[0])
+		if err != nil {
+			return nil, 0, err
+		}
+		last, err := GetLogEntry(follower.logservice.Client, links[len(links)-1])
+		if err != nil {
+			return nil, 0, err
+		}
+		follower.newestFirst = entryAfter(first, last)
+		follower.orderKnown = true
+	}
+
+	if !follower.newestFirst {
+		return links, base, nil
+	}
+
+	reversed := make([]string, len(links))
+	for i, link := range links {
+		reversed[len(links)-1-i] = link
+	}
+	return reversed, -1, nil
+}
+
+// resume finds where new entries start in the candidate links. It returns
+// -1 if the last entry seen is no longer in the log.
+func (follower *LogFollower) resume(position *LogPosition, links []string) (int, error) {
+	// The log was empty when last seen, so all entries are new.
+	if position.ODataID == "" {
+		return 0, nil
+	}
+
+	for i, link := range links {
+		if link != position.ODataID {
+			continue
+		}
+
+		// Services may reuse Ids once the log is cleared or wraps, so the
+		// entry must be the one that was seen.
+		entry, err := GetLogEntry(follower.logservice.Client, link)
+		if err != nil {
+			return 0, err
+		}
+		if entry.ID == position.ID && entry.Created == position.Created {
+			return i + 1, nil
+		}
+		return -1, nil
+	}
+
+	return -1, nil
+}
+
+// classifyReset determines whether the log was cleared or wrapped. A log
+// that never overwrites entries can only have been cleared. Otherwise it
+// is based on whether the oldest entry left in the log was created before
+// the last entry seen.
+func (follower *LogFollower) classifyReset(position *LogPosition) (LogReset, error) {
+	if follower.logservice.OverWritePolicy == NeverOverWritesOverWritePolicy {
+		return ClearedLogReset, nil
+	}
+
+	var links []string
+	var err error
+	if follower.TopSkipQuery && !follower.newestFirst {
+		links, _, err = follower.collectionPage("", 0, 1)
+	} else {
+		links, err = follower.entryLinks("", 0)
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(links) == 0 {
+		return ClearedLogReset, nil
+	}
+
+	oldestLink := links[0]
+	if follower.newestFirst {
+		oldestLink = links[len(links)-1]
+	}
+	oldest, err := GetLogEntry(follower.logservice.Client, oldestLink)
+	if err != nil {
+		return "", err
+	}
+	if createdAfter(oldest.Created, position.Created) {
+		return ClearedLogReset, nil
+	}
+	return WrappedLogReset, nil
+}
+
+// entryLinks lists the entries matching the filter, starting at the given
+// index. Pages are requested with $top and $skip when enabled, and the
+// Members@odata.nextLink of partial pages is followed.
+func (follower *LogFollower) entryLinks(filter string, skip int) ([]string, error) {
+	if !follower.TopSkipQuery {
+		links, next, err := follower.collectionPage(filter, 0, 0)
+		for next != "" && err == nil {
+			var page []string
+			page, next, err = follower.pageLinks(next)
+			links = append(links, page...)
+		}
+		return links, err
+	}
+
+	pageSize := follower.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultLogPageSize
+	}
+
+	var result []string
+	for {
+		links, next, err := follower.collectionPage(filter, skip, pageSize)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, links...)
+
+		// Services may return fewer entries than requested per page, in
+		// which case they link to the rest.
+		if len(links) == 0 || (len(links) < pageSize && next == "") {
+			return result, nil
+		}
+		skip += len(links)
+	}
+}
+
+// collectionPage gets the links in one page of the entry collection, and
+// the link to the next page, if any. A top of zero requests all entries.
+func (follower *LogFollower) collectionPage(filter string, skip, top int) ([]string, string, error) {
+	var query []string
+	if filter != "" {
+		// Spaces must be sent as %20 rather than +.
+		query = append(query, "$filter="+strings.ReplaceAll(url.QueryEscape(filter), "+", "%20"))
+	}
+	if skip > 0 {
+		query = append(query, fmt.Sprintf("$skip=%d", skip))
+	}
+	if top > 0 {
+		query = append(query, fmt.Sprintf("$top=%d", top))
+	}
+
+	uri := follower.logservice.entries
+	if len(query) > 0 {
+		uri += "?" + strings.Join(query, "&")
+	}
+
+	return follower.pageLinks(uri)
+}
+
+// pageLinks gets the links in the page of the entry collection at the given
+// location, and the link to the page after it, if any.
+func (follower *LogFollower) pageLinks(uri string) ([]string, string, error) {
+	collection, err := common.GetCollection(follower.logservice.Client, uri)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Guard against services that link a page to itself.
+	if collection.NextLink == uri {
+		return collection.ItemLinks, "", nil
+	}
+	return collection.ItemLinks, collection.NextLink, nil
+}
+
+// entryAfter indicates whether entry a was created after entry b, by their
+// Created time or, if those are the same, by their numeric Ids.
+func entryAfter(a, b *LogEntry) bool {
+	if a.Created != b.Created {
+		return createdAfter(a.Created, b.Created)
+	}
+
+	idA, errA := strconv.Atoi(a.ID)
+	idB, errB := strconv.Atoi(b.ID)
+	return errA == nil && errB == nil && idA > idB
+}
+
+// createdAfter indicates whether timestamp a is later than b. Timestamps
+// that cannot be parsed are compared as strings.
+func createdAfter(a, b string) bool {
+	timeA, errA := time.Parse(time.RFC3339, a)
+	timeB, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a > b
+	}
+	return timeA.After(timeB)
+}
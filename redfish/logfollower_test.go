@@ -0,0 +1,376 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const followerEntries = "/redfish/v1/Managers/BMC/LogServices/SEL/Entries"
+
+// followerCollection renders an entry collection with the given entry Ids.
+func followerCollection(ids ...string) string {
+	members := make([]string, len(ids))
+	for i, id := range ids {
+		members[i] = fmt.Sprintf(`{"@odata.id": "%s/%s"}`, followerEntries, id)
+	}
+	return fmt.Sprintf(`{"Members": [%s], "Members@odata.count": %d}`, strings.Join(members, ","), len(ids))
+}
+
+// setFollowerEntry adds the response for an entry to the client.
+func setFollowerEntry(c *testClient, id, created string) {
+	c.responses["GET "+followerEntries+"/"+id] = fmt.Sprintf(
+		`{"@odata.id": "%s/%s", "Id": "%s", "Created": "%s"}`, followerEntries, id, id, created)
+}
+
+// newTestLogFollower creates a follower for a log with the first entries.
+func newTestLogFollower() (*LogFollower, *testClient) {
+	c := &testClient{responses: map[string]string{
+		"GET " + followerEntries: followerCollection("1", "2"),
+	}}
+	setFollowerEntry(c, "1", "2020-01-01T00:00:01Z")
+	setFollowerEntry(c, "2", "2020-01-01T00:00:02Z")
+
+	logservice := &LogService{entries: followerEntries}
+	logservice.ODataID = "/redfish/v1/Managers/BMC/LogServices/SEL"
+	logservice.SetClient(c)
+
+	return NewLogFollower(logservice, NewMemoryLogStateStore()), c
+}
+
+// pollIDs polls the follower and returns the Ids of the new entries.
+func pollIDs(t *testing.T, follower *LogFollower) ([]string, *LogPollResult) {
+	var ids []string
+	result, err := follower.Poll(func(entry *LogEntry) error {
+		ids = append(ids, entry.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error polling log: %s", err)
+	}
+	return ids, result
+}
+
+// TestLogFollowerPoll tests that only new entries are passed on.
+func TestLogFollowerPoll(t *testing.T) {
+	follower, c := newTestLogFollower()
+
+	ids, _ := pollIDs(t, follower)
+	if strings.Join(ids, ",") != "1,2" {
+		t.Errorf("Unexpected first poll entries: %v", ids)
+	}
+
+	c.responses["GET "+followerEntries] = followerCollection("1", "2", "3")
+	setFollowerEntry(c, "3", "2020-01-01T00:00:03Z")
+	c.calls = nil
+
+	ids, result := pollIDs(t, follower)
+	if strings.Join(ids, ",") != "3" || result.Reset != "" {
+		t.Errorf("Unexpected second poll: %v %s", ids, result.Reset)
+	}
+
+	// Only the collection, the last entry seen and the new entry are read.
+	if len(c.calls) != 3 {
+		t.Errorf("Expected 3 calls, got %d", len(c.calls))
+	}
+
+	ids, _ = pollIDs(t, follower)
+	if len(ids) != 0 {
+		t.Errorf("Unexpected entries without changes: %v", ids)
+	}
+}
+
+// TestLogFollowerHandlerError tests that a failed entry is passed on again.
+func TestLogFollowerHandlerError(t *testing.T) {
+	follower, _ := newTestLogFollower()
+
+	_, err := follower.Poll(func(entry *LogEntry) error {
+		if entry.ID == "2" {
+			return fmt.Errorf("SIEM unavailable")
+		}
+		return nil
+	})
+	if err == nil {
+		t.Error("Expected handler error")
+	}
+
+	ids, _ := pollIDs(t, follower)
+	if strings.Join(ids, ",") != "2" {
+		t.Errorf("Unexpected entries after failure: %v", ids)
+	}
+}
+
+// countingLogStateStore counts the positions saved to a store.
+type countingLogStateStore struct {
+	*MemoryLogStateStore
+	saves int
+}
+
+// SaveLogPosition saves the position and counts it.
+func (store *countingLogStateStore) SaveLogPosition(key string, position *LogPosition) error {
+	store.saves++
+	return store.MemoryLogStateStore.SaveLogPosition(key, position)
+}
+
+// TestLogFollowerSaveOnce tests that the position is saved once per poll.
+func TestLogFollowerSaveOnce(t *testing.T) {
+	follower, c := newTestLogFollower()
+	store := &countingLogStateStore{MemoryLogStateStore: NewMemoryLogStateStore()}
+	follower.store = store
+
+	c.responses["GET "+followerEntries] = followerCollection("1", "2", "3")
+	setFollowerEntry(c, "3", "2020-01-01T00:00:03Z")
+
+	pollIDs(t, follower)
+	if store.saves != 1 {
+		t.Errorf("Expected one save, got %d", store.saves)
+	}
+
+	pollIDs(t, follower)
+	if store.saves != 1 {
+		t.Errorf("Expected no save without new entries, got %d", store.saves)
+	}
+}
+
+// TestLogFollowerCleared tests detecting a cleared log.
+func TestLogFollowerCleared(t *testing.T) {
+	follower, c := newTestLogFollower()
+	pollIDs(t, follower)
+
+	var resets []LogReset
+	follower.ResetHandler = func(reset LogReset) {
+		resets = append(resets, reset)
+	}
+
+	// The service reuses Id 1 after clearing.
+	c.responses["GET "+followerEntries] = followerCollection("1")
+	setFollowerEntry(c, "1", "2020-01-01T00:01:00Z")
+
+	ids, result := pollIDs(t, follower)
+	if result.Reset != ClearedLogReset || len(resets) != 1 {
+		t.Errorf("Expected cleared log, got %s", result.Reset)
+	}
+	if strings.Join(ids, ",") != "1" {
+		t.Errorf("Unexpected entries after clear: %v", ids)
+	}
+}
+
+// TestLogFollowerResetOnce tests that a reset is only reported by the poll
+// that found it, also when no entries are handled.
+func TestLogFollowerResetOnce(t *testing.T) {
+	follower, c := newTestLogFollower()
+	pollIDs(t, follower)
+
+	resets := 0
+	follower.ResetHandler = func(reset LogReset) {
+		resets++
+	}
+
+	// The log was cleared and stays empty.
+	c.responses["GET "+followerEntries] = followerCollection()
+	for i := 0; i < 2; i++ {
+		ids, _ := pollIDs(t, follower)
+		if len(ids) != 0 {
+			t.Errorf("Unexpected entries in an empty log: %v", ids)
+		}
+	}
+	if resets != 1 {
+		t.Errorf("Expected the clear to be reported once, got %d", resets)
+	}
+
+	c.responses["GET "+followerEntries] = followerCollection("1")
+	setFollowerEntry(c, "1", "2020-01-01T00:01:00Z")
+
+	ids, result := pollIDs(t, follower)
+	if strings.Join(ids, ",") != "1" || result.Reset != "" {
+		t.Errorf("Unexpected poll after the empty log: %v %s", ids, result.Reset)
+	}
+
+	// Entry 1 was overwritten by entry 2, which is older than the entry seen.
+	c.responses["GET "+followerEntries] = followerCollection("2")
+	setFollowerEntry(c, "2", "2020-01-01T00:00:30Z")
+	for i := 0; i < 2; i++ {
+		ids, _ := pollIDs(t, follower)
+		if len(ids) != 0 {
+			t.Errorf("Unexpected entries after wrapping: %v", ids)
+		}
+	}
+	if resets != 2 {
+		t.Errorf("Expected the wrap to be reported once, got %d resets", resets)
+	}
+}
+
+// TestLogFollowerWrapped tests detecting a log that wrapped around.
+func TestLogFollowerWrapped(t *testing.T) {
+	follower, c := newTestLogFollower()
+	pollIDs(t, follower)
+
+	// Entry 2 was overwritten by new entries 3 and 4, entry 1 remains.
+	c.responses["GET "+followerEntries] = followerCollection("1", "3", "4")
+	setFollowerEntry(c, "3", "2020-01-01T00:00:03Z")
+	setFollowerEntry(c, "4", "2020-01-01T00:00:04Z")
+	delete(c.responses, "GET "+followerEntries+"/2")
+
+	ids, result := pollIDs(t, follower)
+	if result.Reset != WrappedLogReset {
+		t.Errorf("Expected wrapped log, got %s", result.Reset)
+	}
+	if strings.Join(ids, ",") != "3,4" {
+		t.Errorf("Unexpected entries after wrap: %v", ids)
+	}
+}
+
+// TestLogFollowerNeverOverWrites tests that a log that never overwrites
+// entries is only reported as cleared.
+func TestLogFollowerNeverOverWrites(t *testing.T) {
+	follower, c := newTestLogFollower()
+	follower.logservice.OverWritePolicy = NeverOverWritesOverWritePolicy
+	pollIDs(t, follower)
+
+	// The service reuses the Ids after clearing, and its clock was set back.
+	setFollowerEntry(c, "1", "2019-12-31T00:00:01Z")
+	setFollowerEntry(c, "2", "2019-12-31T00:00:02Z")
+
+	ids, result := pollIDs(t, follower)
+	if result.Reset != ClearedLogReset {
+		t.Errorf("Expected cleared log, got %s", result.Reset)
+	}
+	if strings.Join(ids, ",") != "1,2" {
+		t.Errorf("Unexpected entries after clearing: %v", ids)
+	}
+}
+
+// TestLogFollowerQueries tests the use of $filter, $top and $skip.
+func TestLogFollowerQueries(t *testing.T) {
+	follower, c := newTestLogFollower()
+	follower.TopSkipQuery = true
+	follower.PageSize = 2
+
+	c.responses["GET "+followerEntries+"?$top=2"] = followerCollection("1", "2")
+	c.responses["GET "+followerEntries+"?$skip=2&$top=2"] = followerCollection()
+
+	ids, _ := pollIDs(t, follower)
+	if strings.Join(ids, ",") != "1,2" {
+		t.Errorf("Unexpected first poll entries: %v", ids)
+	}
+
+	c.responses["GET "+followerEntries+"?$skip=1&$top=2"] = followerCollection("2", "3")
+	c.responses["GET "+followerEntries+"?$skip=3&$top=2"] = followerCollection()
+	setFollowerEntry(c, "3", "2020-01-01T00:00:03Z")
+
+	ids, _ = pollIDs(t, follower)
+	if strings.Join(ids, ",") != "3" {
+		t.Errorf("Unexpected skip poll entries: %v", ids)
+	}
+
+	follower.FilterQuery = true
+	filter := "?$filter=Created%20ge%20%272020-01-01T00%3A00%3A03Z%27&$top=2"
+	c.responses["GET "+followerEntries+filter] = followerCollection("3")
+	c.calls = nil
+
+	ids, _ = pollIDs(t, follower)
+	if len(ids) != 0 {
+		t.Errorf("Unexpected filter poll entries: %v", ids)
+	}
+	if c.calls[0].URL != followerEntries+filter {
+		t.Errorf("Unexpected filter query: %s", c.calls[0].URL)
+	}
+}
+
+// TestLogFollowerFilterMissingEntry tests that a filtered listing without
+// the last entry seen is not taken for a reset.
+func TestLogFollowerFilterMissingEntry(t *testing.T) {
+	follower, c := newTestLogFollower()
+	pollIDs(t, follower)
+
+	follower.FilterQuery = true
+	filter := "?$filter=Created%20ge%20%272020-01-01T00%3A00%3A02Z%27"
+	c.responses["GET "+followerEntries+filter] = followerCollection("3")
+	c.responses["GET "+followerEntries] = followerCollection("1", "2", "3")
+	setFollowerEntry(c, "3", "2020-01-01T00:00:03Z")
+
+	ids, result := pollIDs(t, follower)
+	if strings.Join(ids, ",") != "3" || result.Reset != "" {
+		t.Errorf("Unexpected poll: %v %s", ids, result.Reset)
+	}
+}
+
+// TestLogFollowerNextLink tests following the pages of a collection the
+// service splits with Members@odata.nextLink.
+func TestLogFollowerNextLink(t *testing.T) {
+	follower, c := newTestLogFollower()
+
+	next := followerEntries + "?$skip=2"
+	c.responses["GET "+followerEntries] = fmt.Sprintf(`{
+		"Members": [{"@odata.id": "%s/1"}, {"@odata.id": "%s/2"}],
+		"Members@odata.count": 3,
+		"Members@odata.nextLink": "%s"
+	}`, followerEntries, followerEntries, next)
+	c.responses["GET "+next] = followerCollection("3")
+	setFollowerEntry(c, "3", "2020-01-01T00:00:03Z")
+
+	ids, _ := pollIDs(t, follower)
+	if strings.Join(ids, ",") != "1,2,3" {
+		t.Errorf("Unexpected entries: %v", ids)
+	}
+}
+
+// TestLogFollowerNewestFirst tests following a log that lists the newest
+// entries first.
+func TestLogFollowerNewestFirst(t *testing.T) {
+	follower, c := newTestLogFollower()
+	c.responses["GET "+followerEntries] = followerCollection("2", "1")
+
+	ids, _ := pollIDs(t, follower)
+	if strings.Join(ids, ",") != "1,2" {
+		t.Errorf("Unexpected first poll entries: %v", ids)
+	}
+
+	c.responses["GET "+followerEntries] = followerCollection("4", "3", "2", "1")
+	setFollowerEntry(c, "3", "2020-01-01T00:00:03Z")
+	setFollowerEntry(c, "4", "2020-01-01T00:00:04Z")
+
+	ids, result := pollIDs(t, follower)
+	if strings.Join(ids, ",") != "3,4" || result.Reset != "" {
+		t.Errorf("Unexpected second poll: %v %s", ids, result.Reset)
+	}
+
+	// The service reuses Id 1 after clearing.
+	c.responses["GET "+followerEntries] = followerCollection("2", "1")
+	setFollowerEntry(c, "1", "2020-01-01T00:01:00Z")
+	setFollowerEntry(c, "2", "2020-01-01T00:01:01Z")
+
+	ids, result = pollIDs(t, follower)
+	if strings.Join(ids, ",") != "1,2" || result.Reset != ClearedLogReset {
+		t.Errorf("Unexpected poll after clearing: %v %s", ids, result.Reset)
+	}
+}
+
+// TestFileLogStateStore tests saving positions to a file.
+func TestFileLogStateStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "positions.json")
+
+	store := &FileLogStateStore{Path: path}
+	position, err := store.LoadLogPosition("sel")
+	if err != nil || position != nil {
+		t.Errorf("Expected no position, got %v %v", position, err)
+	}
+
+	err = store.SaveLogPosition("sel", &LogPosition{ID: "2", Created: "2020-01-01T00:00:02Z", Index: 1})
+	if err != nil {
+		t.Errorf("Error saving position: %s", err)
+	}
+
+	position, err = (&FileLogStateStore{Path: path}).LoadLogPosition("sel")
+	if err != nil {
+		t.Errorf("Error loading position: %s", err)
+	}
+	if position == nil || position.ID != "2" || position.Index != 1 {
+		t.Errorf("Unexpected position: %v", position)
+	}
+}
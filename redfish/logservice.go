@@ -6,6 +6,11 @@ package redfish
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/rocksolidlabs/gofish/common"
 )
@@ -40,6 +45,20 @@ const (
 	NeverOverWritesOverWritePolicy OverWritePolicy = "NeverOverWrites"
 )
 
+// DiagnosticDataType is the type of diagnostic data to collect.
+type DiagnosticDataType string
+
+const (
+	// ManagerDiagnosticDataType Manager diagnostic data.
+	ManagerDiagnosticDataType DiagnosticDataType = "Manager"
+	// PreOSDiagnosticDataType Pre-OS diagnostic data.
+	PreOSDiagnosticDataType DiagnosticDataType = "PreOS"
+	// OSDiagnosticDataType Operating system (OS) diagnostic data.
+	OSDiagnosticDataType DiagnosticDataType = "OS"
+	// OEMDiagnosticDataType OEM diagnostic data.
+	OEMDiagnosticDataType DiagnosticDataType = "OEM"
+)
+
 // LogService is used to represent a log service for a Redfish
 // implementation.
 type LogService struct {
@@ -85,13 +104,31 @@ type LogService struct {
 	ServiceEnabled bool
 	// Status shall contain any status or health properties of the resource.
 	Status common.Status
+	// DiagnosticDataTypes are the allowed values for the
+	// CollectDiagnosticData action.
+	DiagnosticDataTypes []DiagnosticDataType
+	// clearLogTarget is the URL to send ClearLog requests.
+	clearLogTarget string
+	// collectDiagnosticDataTarget is the URL to send CollectDiagnosticData
+	// requests.
+	collectDiagnosticDataTarget string
 }
 
 // UnmarshalJSON unmarshals a LogService object from the raw JSON.
 func (logservice *LogService) UnmarshalJSON(b []byte) error {
 	type temp LogService
+	type actions struct {
+		ClearLog struct {
+			Target string
+		} `json:"#LogService.ClearLog"`
+		CollectDiagnosticData struct {
+			AllowableValues []DiagnosticDataType `json:"DiagnosticDataType@Redfish.AllowableValues"`
+			Target          string
+		} `json:"#LogService.CollectDiagnosticData"`
+	}
 	var t struct {
 		temp
+		Actions actions
 		Entries common.Link
 	}
 
@@ -103,6 +140,9 @@ func (logservice *LogService) UnmarshalJSON(b []byte) error {
 	// Extract the links to other entities for later
 	*logservice = LogService(t.temp)
 	logservice.entries = string(t.Entries)
+	logservice.clearLogTarget = t.Actions.ClearLog.Target
+	logservice.collectDiagnosticDataTarget = t.Actions.CollectDiagnosticData.Target
+	logservice.DiagnosticDataTypes = t.Actions.CollectDiagnosticData.AllowableValues
 
 	return nil
 }
@@ -152,3 +192,108 @@ func ListReferencedLogServices(c common.Client, link string) ([]*LogService, err
 func (logservice *LogService) Entries() ([]*LogEntry, error) {
 	return ListReferencedLogEntrys(logservice.Client, logservice.entries)
 }
+
+// ClearLog removes all entries from the log. This is needed to resume
+// logging when a log with the NeverOverWrites policy is full.
+func (logservice *LogService) ClearLog() error {
+	if logservice.clearLogTarget == "" {
+		return fmt.Errorf("ClearLog is not supported by this service")
+	}
+
+	resp, err := logservice.Post(logservice.clearLogTarget, struct{}{})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// CollectDiagnosticData asks the service to collect diagnostic data into a
+// new log entry and downloads it. If the service collects the data in a
+// task, the task is polled at the given interval until it finishes or the
+// timeout passes. The oemType is only used with OEMDiagnosticDataType. The
+// returned data is streamed from the service and the caller must close it.
+func (logservice *LogService) CollectDiagnosticData(dataType DiagnosticDataType, oemType string, interval, timeout time.Duration) (*LogEntry, io.ReadCloser, error) {
+	if logservice.collectDiagnosticDataTarget == "" {
+		return nil, nil, fmt.Errorf("CollectDiagnosticData is not supported by this service")
+	}
+
+	t := struct {
+		DiagnosticDataType    DiagnosticDataType
+		OEMDiagnosticDataType string `json:",omitempty"`
+	}{
+		DiagnosticDataType:    dataType,
+		OEMDiagnosticDataType: oemType,
+	}
+
+	resp, err := logservice.Post(logservice.collectDiagnosticDataTarget, t)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entryURI := ""
+	if resp.StatusCode == http.StatusAccepted {
+		monitor, err := NewTaskMonitor(logservice.Client, resp)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		task, err := monitor.Wait(interval, timeout)
+		if err != nil {
+			return nil, nil, err
+		}
+		if task.TaskState != CompletedTaskState {
+			return nil, nil, fmt.Errorf("diagnostic data collection task %s ended in state %s", task.ID, task.TaskState)
+		}
+
+		entryURI, err = collectedEntryLocation(logservice.Client, monitor, task)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		entryURI = resp.Header.Get("Location")
+		resp.Body.Close()
+	}
+
+	if entryURI == "" {
+		return nil, nil, fmt.Errorf("service did not report the log entry of the collected data")
+	}
+
+	entry, err := GetLogEntry(logservice.Client, entryURI)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := entry.AdditionalData()
+	if err != nil {
+		return entry, nil, err
+	}
+
+	return entry, data, nil
+}
+
+// collectedEntryLocation finds the location of the log entry created by a
+// finished task. Services report it either in the Location header of the
+// task payload, or in the Location header returned by the task monitor once
+// the task has completed, usually with status 201 Created or 204 No Content.
+func collectedEntryLocation(c common.Client, monitor *TaskMonitor, task *Task) (string, error) {
+	for _, header := range task.Payload.HTTPHeaders {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) == 2 && strings.EqualFold(strings.TrimSpace(parts[0]), "Location") {
+			return strings.TrimSpace(parts[1]), nil
+		}
+	}
+
+	if monitor.Location != "" || monitor.URI == "" {
+		return monitor.Location, nil
+	}
+
+	resp, err := c.GetAccepted(monitor.URI)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+
+	return resp.Header.Get("Location"), nil
+}
@@ -6,8 +6,11 @@ package redfish
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
 )
 
 var logServiceBody = strings.NewReader(
@@ -29,6 +32,18 @@ var logServiceBody = strings.NewReader(
 		"Status": {
 			"State": "Enabled",
 			"Health": "OK"
+		},
+		"Actions": {
+			"#LogService.ClearLog": {
+				"target": "/redfish/v1/LogService/Actions/LogService.ClearLog"
+			},
+			"#LogService.CollectDiagnosticData": {
+				"target": "/redfish/v1/LogService/Actions/LogService.CollectDiagnosticData",
+				"DiagnosticDataType@Redfish.AllowableValues": [
+					"Manager",
+					"OEM"
+				]
+			}
 		}
 	}`)
 
@@ -68,4 +83,197 @@ func TestLogService(t *testing.T) {
 	if !result.ServiceEnabled {
 		t.Error("Service should be enabled")
 	}
+
+	if result.clearLogTarget != "/redfish/v1/LogService/Actions/LogService.ClearLog" {
+		t.Errorf("Invalid ClearLog target: %s", result.clearLogTarget)
+	}
+
+	if result.collectDiagnosticDataTarget != "/redfish/v1/LogService/Actions/LogService.CollectDiagnosticData" {
+		t.Errorf("Invalid CollectDiagnosticData target: %s", result.collectDiagnosticDataTarget)
+	}
+
+	if len(result.DiagnosticDataTypes) != 2 || result.DiagnosticDataTypes[1] != OEMDiagnosticDataType {
+		t.Errorf("Invalid diagnostic data types: %v", result.DiagnosticDataTypes)
+	}
+}
+
+// TestLogServiceClearLog tests the ClearLog call.
+func TestLogServiceClearLog(t *testing.T) {
+	var result LogService
+	err := json.NewDecoder(strings.NewReader(`{
+		"@odata.id": "/redfish/v1/Managers/BMC/LogServices/SEL",
+		"Id": "SEL",
+		"OverWritePolicy": "NeverOverWrites",
+		"Actions": {
+			"#LogService.ClearLog": {
+				"target": "/redfish/v1/Managers/BMC/LogServices/SEL/Actions/LogService.ClearLog"
+			}
+		}
+	}`)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	testClient := &testClient{}
+	result.SetClient(testClient)
+
+	err = result.ClearLog()
+	if err != nil {
+		t.Errorf("Error making ClearLog call: %s", err)
+	}
+
+	calls := testClient.calls
+	if len(calls) != 1 {
+		t.Fatalf("Expected one call, got %d", len(calls))
+	}
+
+	if calls[0].Method != http.MethodPost ||
+		calls[0].URL != "/redfish/v1/Managers/BMC/LogServices/SEL/Actions/LogService.ClearLog" {
+		t.Errorf("Unexpected call: %s %s", calls[0].Method, calls[0].URL)
+	}
+
+	_, _, err = result.CollectDiagnosticData(ManagerDiagnosticDataType, "", time.Millisecond, time.Second)
+	if err == nil {
+		t.Error("CollectDiagnosticData should fail when not supported")
+	}
+}
+
+// TestLogServiceCollectDiagnosticData tests collecting diagnostic data
+// through a task.
+func TestLogServiceCollectDiagnosticData(t *testing.T) {
+	target := "/redfish/v1/Managers/BMC/LogServices/Dump/Actions/LogService.CollectDiagnosticData"
+	var result LogService
+	err := json.NewDecoder(strings.NewReader(`{
+		"@odata.id": "/redfish/v1/Managers/BMC/LogServices/Dump",
+		"Id": "Dump",
+		"Actions": {
+			"#LogService.CollectDiagnosticData": {
+				"target": "` + target + `"
+			}
+		}
+	}`)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	testClient := &testClient{
+		statuses: map[string]int{
+			"POST " + target: http.StatusAccepted,
+		},
+		headers: map[string]http.Header{
+			"POST " + target: {"Location": {"/redfish/v1/TaskService/Tasks/7/Monitor"}},
+		},
+		responses: map[string]string{
+			"POST " + target: `{"@odata.id": "/redfish/v1/TaskService/Tasks/7"}`,
+			"GET /redfish/v1/TaskService/Tasks/7/Monitor": `{
+				"@odata.id": "/redfish/v1/TaskService/Tasks/7",
+				"Id": "7",
+				"TaskState": "Completed",
+				"Payload": {
+					"HttpHeaders": ["Location: /redfish/v1/Managers/BMC/LogServices/Dump/Entries/3"]
+				}
+			}`,
+			"GET /redfish/v1/Managers/BMC/LogServices/Dump/Entries/3": `{
+				"@odata.id": "/redfish/v1/Managers/BMC/LogServices/Dump/Entries/3",
+				"Id": "3",
+				"DiagnosticDataType": "OEM",
+				"OEMDiagnosticDataType": "Crashdump",
+				"AdditionalDataSizeBytes": 9,
+				"AdditionalDataURI": "/redfish/v1/Managers/BMC/LogServices/Dump/Entries/3/attachment"
+			}`,
+			"GET /redfish/v1/Managers/BMC/LogServices/Dump/Entries/3/attachment": "dump data",
+		},
+	}
+	result.SetClient(testClient)
+
+	entry, data, err := result.CollectDiagnosticData(OEMDiagnosticDataType, "Crashdump", time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatalf("Error making CollectDiagnosticData call: %s", err)
+	}
+	defer data.Close()
+
+	if testClient.calls[0].Payload != `{"DiagnosticDataType":"OEM","OEMDiagnosticDataType":"Crashdump"}` {
+		t.Errorf("Unexpected CollectDiagnosticData payload: %s", testClient.calls[0].Payload)
+	}
+
+	if entry.ID != "3" || entry.DiagnosticDataType != OEMDiagnosticDataType {
+		t.Errorf("Unexpected log entry: %s %s", entry.ID, entry.DiagnosticDataType)
+	}
+
+	contents, err := ioutil.ReadAll(data)
+	if err != nil {
+		t.Errorf("Error reading diagnostic data: %s", err)
+	}
+
+	if string(contents) != "dump data" {
+		t.Errorf("Unexpected diagnostic data: %s", contents)
+	}
+}
+
+// TestLogServiceCollectDiagnosticDataCreated tests collecting diagnostic
+// data when the task monitor reports the log entry in its Location header.
+func TestLogServiceCollectDiagnosticDataCreated(t *testing.T) {
+	target := "/redfish/v1/Managers/BMC/LogServices/Dump/Actions/LogService.CollectDiagnosticData"
+	monitor := "GET /redfish/v1/TaskService/TaskMonitors/9"
+	result := LogService{collectDiagnosticDataTarget: target}
+
+	testClient := &testClient{
+		statuses: map[string]int{
+			"POST " + target: http.StatusAccepted,
+			monitor:          http.StatusCreated,
+		},
+		headers: map[string]http.Header{
+			"POST " + target: {"Location": {"/redfish/v1/TaskService/TaskMonitors/9"}},
+			monitor:          {"Location": {"/redfish/v1/Managers/BMC/LogServices/Dump/Entries/4"}},
+		},
+		responses: map[string]string{
+			monitor: "",
+			"GET /redfish/v1/Managers/BMC/LogServices/Dump/Entries/4": `{
+				"@odata.id": "/redfish/v1/Managers/BMC/LogServices/Dump/Entries/4",
+				"Id": "4",
+				"DiagnosticDataType": "Manager",
+				"AdditionalDataURI": "/redfish/v1/Managers/BMC/LogServices/Dump/Entries/4/attachment"
+			}`,
+			"GET /redfish/v1/Managers/BMC/LogServices/Dump/Entries/4/attachment": "dump data",
+		},
+	}
+	result.SetClient(testClient)
+
+	entry, data, err := result.CollectDiagnosticData(ManagerDiagnosticDataType, "", time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatalf("Error making CollectDiagnosticData call: %s", err)
+	}
+	defer data.Close()
+
+	if entry.ID != "4" {
+		t.Errorf("Unexpected log entry: %s", entry.ID)
+	}
+}
+
+// TestLogServiceCollectDiagnosticDataFailed tests a failed collection task.
+func TestLogServiceCollectDiagnosticDataFailed(t *testing.T) {
+	target := "/redfish/v1/Managers/BMC/LogServices/Dump/Actions/LogService.CollectDiagnosticData"
+	result := LogService{collectDiagnosticDataTarget: target}
+
+	testClient := &testClient{
+		statuses: map[string]int{
+			"POST " + target: http.StatusAccepted,
+		},
+		headers: map[string]http.Header{
+			"POST " + target: {"Location": {"/redfish/v1/TaskService/Tasks/8"}},
+		},
+		responses: map[string]string{
+			"GET /redfish/v1/TaskService/Tasks/8": `{
+				"@odata.id": "/redfish/v1/TaskService/Tasks/8",
+				"Id": "8",
+				"TaskState": "Exception"
+			}`,
+		},
+	}
+	result.SetClient(testClient)
+
+	_, _, err := result.CollectDiagnosticData(ManagerDiagnosticDataType, "", time.Millisecond, time.Second)
+	if err == nil || !strings.Contains(err.Error(), "Exception") {
+		t.Errorf("Expected failed task error, got: %v", err)
+	}
 }
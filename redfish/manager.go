@@ -341,3 +341,12 @@ func ListReferencedManagers(c common.Client, link string) ([]*Manager, error) {
 
 	return result, nil
 }
+
+// NetworkProtocol gets the network protocol settings of this manager.
+func (manager *Manager) NetworkProtocol() (*ManagerNetworkProtocol, error) {
+	if manager.networkProtocol == "" {
+		return nil, nil
+	}
+
+	return GetManagerNetworkProtocol(manager.Client, manager.networkProtocol)
+}
@@ -0,0 +1,160 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// NotifyIPv6Scope is the IPv6 scope for SSDP notifications.
+type NotifyIPv6Scope string
+
+const (
+	// LinkNotifyIPv6Scope SSDP NOTIFY messages are sent to addresses in the
+	// IPv6 Local Link scope.
+	LinkNotifyIPv6Scope NotifyIPv6Scope = "Link"
+	// SiteNotifyIPv6Scope SSDP NOTIFY messages are sent to addresses in the
+	// IPv6 Local Site scope.
+	SiteNotifyIPv6Scope NotifyIPv6Scope = "Site"
+	// OrganizationNotifyIPv6Scope SSDP NOTIFY messages are sent to addresses
+	// in the IPv6 Local Organization scope.
+	OrganizationNotifyIPv6Scope NotifyIPv6Scope = "Organization"
+)
+
+// ProtocolSettings shall contain the settings of a network protocol
+// supported by the manager.
+type ProtocolSettings struct {
+	// Port shall contain the port assigned for the protocol.
+	Port int
+	// ProtocolEnabled shall indicate whether the protocol is enabled.
+	ProtocolEnabled bool
+}
+
+// HTTPSProtocol shall contain the settings of the HTTPS protocol supported by
+// the manager.
+type HTTPSProtocol struct {
+	ProtocolSettings
+	// certificates shall contain a link to a resource collection of type
+	// CertificateCollection.
+	certificates string
+}
+
+// UnmarshalJSON unmarshals a HTTPSProtocol object from the raw JSON.
+func (https *HTTPSProtocol) UnmarshalJSON(b []byte) error {
+	var t struct {
+		ProtocolSettings
+		Certificates common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	https.ProtocolSettings = t.ProtocolSettings
+
+	// Extract the links to other entities for later
+	https.certificates = string(t.Certificates)
+
+	return nil
+}
+
+// NTPProtocol shall contain the settings of the NTP protocol supported by the
+// manager.
+type NTPProtocol struct {
+	ProtocolSettings
+	// NTPServers shall contain all the NTP servers for which this manager is
+	// using to obtain time.
+	NTPServers []string
+}
+
+// SSDProtocol shall contain the settings of the SSDP protocol supported by
+// the manager.
+type SSDProtocol struct {
+	ProtocolSettings
+	// NotifyIPv6Scope shall contain the IPv6 scope for multicast NOTIFY
+	// messages.
+	NotifyIPv6Scope NotifyIPv6Scope
+	// NotifyMulticastIntervalSeconds shall contain the time interval, in
+	// seconds, between transmissions of the multicast NOTIFY ALIVE message.
+	NotifyMulticastIntervalSeconds int
+	// NotifyTTL shall contain the Time-To-Live hop count used for
+	// multicast NOTIFY messages.
+	NotifyTTL int
+}
+
+// ManagerNetworkProtocol is used to represent the network service settings
+// for the manager.
+type ManagerNetworkProtocol struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// DHCP shall contain the DHCP protocol settings for the manager.
+	DHCP ProtocolSettings
+	// DHCPv6 shall contain the DHCPv6 protocol settings for the manager.
+	DHCPv6 ProtocolSettings
+	// Description provides a description of this resource.
+	Description string
+	// FQDN shall contain the fully qualified domain name for the manager.
+	FQDN string
+	// HTTP shall contain the HTTP protocol settings for the manager.
+	HTTP ProtocolSettings
+	// HTTPS shall contain the HTTPS/SSL protocol settings for this manager.
+	HTTPS HTTPSProtocol
+	// HostName shall contain the host name without any domain information.
+	HostName string
+	// IPMI shall contain the IPMI over LAN protocol settings for the
+	// manager.
+	IPMI ProtocolSettings
+	// KVMIP shall contain the KVM-IP protocol settings for the manager.
+	KVMIP ProtocolSettings
+	// NTP shall contain the NTP protocol settings for the manager.
+	NTP NTPProtocol
+	// SNMP shall contain the SNMP protocol settings for this manager.
+	SNMP ProtocolSettings
+	// SSDP shall contain the SSDP protocol settings for this manager.
+	SSDP SSDProtocol
+	// SSH shall contain the SSH protocol settings for the manager.
+	SSH ProtocolSettings
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// Telnet shall contain the Telnet protocol settings for this manager.
+	Telnet ProtocolSettings
+	// VirtualMedia shall contain the Virtual Media protocol settings for this
+	// manager.
+	VirtualMedia ProtocolSettings
+}
+
+// GetManagerNetworkProtocol will get a ManagerNetworkProtocol instance from the service.
+func GetManagerNetworkProtocol(c common.Client, uri string) (*ManagerNetworkProtocol, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var managernetworkprotocol ManagerNetworkProtocol
+	err = json.NewDecoder(resp.Body).Decode(&managernetworkprotocol)
+	if err != nil {
+		return nil, err
+	}
+
+	managernetworkprotocol.SetClient(c)
+	return &managernetworkprotocol, nil
+}
+
+// HTTPSCertificates gets the certificates used by the manager for HTTPS.
+func (managernetworkprotocol *ManagerNetworkProtocol) HTTPSCertificates() ([]*Certificate, error) {
+	return ListReferencedCertificates(managernetworkprotocol.Client, managernetworkprotocol.HTTPS.certificates)
+}
@@ -0,0 +1,93 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var managerNetworkProtocolBody = strings.NewReader(
+	`{
+		"@odata.context": "/redfish/v1/$metadata#ManagerNetworkProtocol.ManagerNetworkProtocol",
+		"@odata.type": "#ManagerNetworkProtocol.v1_4_0.ManagerNetworkProtocol",
+		"@odata.id": "/redfish/v1/Managers/BMC/NetworkProtocol",
+		"Id": "NetworkProtocol",
+		"Name": "Manager Network Protocol",
+		"Status": {
+			"State": "Enabled",
+			"Health": "OK"
+		},
+		"HostName": "web483-bmc",
+		"FQDN": "web483-bmc.dmtf.org",
+		"HTTP": {
+			"ProtocolEnabled": true,
+			"Port": 80
+		},
+		"HTTPS": {
+			"ProtocolEnabled": true,
+			"Port": 443,
+			"Certificates": {
+				"@odata.id": "/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates"
+			}
+		},
+		"IPMI": {
+			"ProtocolEnabled": true,
+			"Port": 623
+		},
+		"SSH": {
+			"ProtocolEnabled": true,
+			"Port": 22
+		},
+		"NTP": {
+			"ProtocolEnabled": true,
+			"Port": 123,
+			"NTPServers": [
+				"pool.ntp.org"
+			]
+		},
+		"SSDP": {
+			"ProtocolEnabled": true,
+			"Port": 1900,
+			"NotifyMulticastIntervalSeconds": 600,
+			"NotifyTTL": 5,
+			"NotifyIPv6Scope": "Site"
+		}
+	}`)
+
+// TestManagerNetworkProtocol tests the parsing of ManagerNetworkProtocol objects.
+func TestManagerNetworkProtocol(t *testing.T) {
+	var result ManagerNetworkProtocol
+	err := json.NewDecoder(managerNetworkProtocolBody).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "NetworkProtocol" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.HostName != "web483-bmc" {
+		t.Errorf("Invalid host name: %s", result.HostName)
+	}
+
+	if !result.HTTPS.ProtocolEnabled || result.HTTPS.Port != 443 {
+		t.Errorf("Invalid HTTPS settings: %v", result.HTTPS)
+	}
+
+	if result.HTTPS.certificates != "/redfish/v1/Managers/BMC/NetworkProtocol/HTTPS/Certificates" {
+		t.Errorf("Invalid HTTPS certificates link: %s", result.HTTPS.certificates)
+	}
+
+	if len(result.NTP.NTPServers) != 1 {
+		t.Errorf("Expected 1 NTP server, got %d", len(result.NTP.NTPServers))
+	}
+
+	if result.SSDP.NotifyIPv6Scope != SiteNotifyIPv6Scope {
+		t.Errorf("Invalid SSDP scope: %s", result.SSDP.NotifyIPv6Scope)
+	}
+}
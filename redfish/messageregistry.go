@@ -0,0 +1,160 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"embed"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// MessageRegistryMessage shall contain the definition of a message in a
+// message registry.
+type MessageRegistryMessage struct {
+	// Description shall indicate how and when this message is returned by
+	// the Redfish service.
+	Description string
+	// Message shall contain the message to display. If a %integer is
+	// included in part of the string, it shall represent a string
+	// substitution for any MessageArgs that accompany the message, in order.
+	Message string
+	// MessageSeverity shall contain the severity of the message.
+	MessageSeverity common.Health
+	// NumberOfArgs shall contain the number of arguments that are
+	// substituted for the locations marked with %<integer> in the message.
+	NumberOfArgs int
+	// ParamTypes shall contain an ordered array of argument data types that
+	// match the data types of the MessageArgs.
+	ParamTypes []string
+	// Resolution shall contain the resolution of the message.
+	Resolution string
+	// Severity shall contain the severity of the condition resulting in the
+	// message. This property has been deprecated in favor of
+	// MessageSeverity.
+	Severity string
+}
+
+// MessageRegistry shall be used to represent a Message Registry for a
+// Redfish implementation.
+type MessageRegistry struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// Language shall be a string consisting of an RFC 5646 style language
+	// code.
+	Language string
+	// Messages shall be the message keys contained in the message registry.
+	// The message keys are the property names of the object.
+	Messages map[string]MessageRegistryMessage
+	// OwningEntity shall be a string that represents the publisher of this
+	// registry.
+	OwningEntity string
+	// RegistryPrefix shall be the single word prefix used to form a messageID
+	// structure.
+	RegistryPrefix string
+	// RegistryVersion shall be the version of this message registry. The
+	// format of this string shall be of the format
+	// majorversion.minorversion.errata.
+	RegistryVersion string
+}
+
+// GetMessageRegistry will get a MessageRegistry instance from the service.
+func GetMessageRegistry(c common.Client, uri string) (*MessageRegistry, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	messageregistry, err := ReadMessageRegistry(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	messageregistry.SetClient(c)
+	return messageregistry, nil
+}
+
+// ReadMessageRegistry reads a message registry from a JSON document, such as
+// a registry file published by the DMTF or a vendor.
+func ReadMessageRegistry(r io.Reader) (*MessageRegistry, error) {
+	var messageregistry MessageRegistry
+	err := json.NewDecoder(r).Decode(&messageregistry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messageregistry, nil
+}
+
+// messageArgPattern matches the %1, %2... argument placeholders of a
+// message.
+var messageArgPattern = regexp.MustCompile(`%[0-9]+`)
+
+// Render substitutes the message arguments into the message text. All the
+// placeholders are replaced in one pass, so arguments that contain a
+// placeholder are not substituted again. Placeholders without an argument
+// are left as they are.
+func (message *MessageRegistryMessage) Render(args []string) string {
+	return messageArgPattern.ReplaceAllStringFunc(message.Message, func(placeholder string) string {
+		i, err := strconv.Atoi(placeholder[1:])
+		if err != nil || i < 1 || i > len(args) {
+			return placeholder
+		}
+		return args[i-1]
+	})
+}
+
+// severity gets the severity of the message, using the deprecated Severity
+// if MessageSeverity is not set.
+func (message *MessageRegistryMessage) severity() common.Health {
+	if message.MessageSeverity != "" {
+		return message.MessageSeverity
+	}
+	return common.Health(message.Severity)
+}
+
+//go:embed registries/*.json
+var bundledRegistryFiles embed.FS
+
+// BundledMessageRegistries gets the standard message registries shipped with
+// this package, for use when a service does not host its registries or
+// cannot be reached.
+func BundledMessageRegistries() ([]*MessageRegistry, error) {
+	var result []*MessageRegistry
+	entries, err := bundledRegistryFiles.ReadDir("registries")
+	if err != nil {
+		return result, err
+	}
+
+	for _, entry := range entries {
+		f, err := bundledRegistryFiles.Open("registries/" + entry.Name())
+		if err != nil {
+			return result, err
+		}
+
+		registry, err := ReadMessageRegistry(f)
+		f.Close()
+		if err != nil {
+			return result, err
+		}
+		result = append(result, registry)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,120 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+var messageRegistryBody = `{
+		"@odata.type": "#MessageRegistry.v1_0_0.MessageRegistry",
+		"Id": "Base.1.4.0",
+		"Name": "Base Message Registry",
+		"Language": "en",
+		"Description": "This registry defines the base messages for Redfish",
+		"RegistryPrefix": "Base",
+		"RegistryVersion": "1.4.0",
+		"OwningEntity": "DMTF",
+		"Messages": {
+			"PropertyValueNotInList": {
+				"Description": "Indicates that a property was given the correct value type but the value of that property was not supported.",
+				"Message": "The value %1 for the property %2 is not in the list of acceptable values.",
+				"Severity": "Warning",
+				"NumberOfArgs": 2,
+				"ParamTypes": [
+					"string",
+					"string"
+				],
+				"Resolution": "Choose a value from the enumeration list that the implementation can support and resubmit the request if the operation failed."
+			}
+		}
+	}`
+
+// TestMessageRegistry tests the parsing of MessageRegistry objects.
+func TestMessageRegistry(t *testing.T) {
+	result, err := ReadMessageRegistry(strings.NewReader(messageRegistryBody))
+
+	if err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "Base.1.4.0" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.RegistryPrefix != "Base" || result.RegistryVersion != "1.4.0" {
+		t.Errorf("Invalid registry: %s %s", result.RegistryPrefix, result.RegistryVersion)
+	}
+
+	message := result.Messages["PropertyValueNotInList"]
+	if message.NumberOfArgs != 2 {
+		t.Errorf("Invalid number of args: %d", message.NumberOfArgs)
+	}
+
+	if message.severity() != common.WarningHealth {
+		t.Errorf("Invalid severity: %s", message.severity())
+	}
+
+	rendered := message.Render([]string{"Blinking", "IndicatorLED"})
+	if rendered != "The value Blinking for the property IndicatorLED is not in the list of acceptable values." {
+		t.Errorf("Invalid rendered message: %s", rendered)
+	}
+}
+
+// TestMessageRegistryRenderManyArgs tests that %1 does not replace the start
+// of %10.
+func TestMessageRegistryRenderManyArgs(t *testing.T) {
+	message := MessageRegistryMessage{Message: "%1 %10"}
+	rendered := message.Render([]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"})
+	if rendered != "a j" {
+		t.Errorf("Invalid rendered message: %s", rendered)
+	}
+}
+
+// TestMessageRegistryRenderArgPlaceholder tests that arguments containing a
+// placeholder are not substituted again.
+func TestMessageRegistryRenderArgPlaceholder(t *testing.T) {
+	message := MessageRegistryMessage{Message: "The value %1 for the property %2 is invalid. %3"}
+	rendered := message.Render([]string{"%2", "%1"})
+	if rendered != "The value %2 for the property %1 is invalid. %3" {
+		t.Errorf("Invalid rendered message: %s", rendered)
+	}
+}
+
+// TestBundledMessageRegistries tests the bundled registries can be read.
+func TestBundledMessageRegistries(t *testing.T) {
+	registries, err := BundledMessageRegistries()
+	if err != nil {
+		t.Fatalf("Error reading bundled registries: %s", err)
+	}
+
+	prefixes := make(map[string]bool)
+	for _, registry := range registries {
+		if len(registry.Messages) == 0 {
+			t.Errorf("Registry %s has no messages", registry.ID)
+		}
+		for key, message := range registry.Messages {
+			for i := 1; i <= message.NumberOfArgs; i++ {
+				if !strings.Contains(message.Message, fmt.Sprintf("%%%d", i)) {
+					t.Errorf("Message %s.%s does not use argument %d", registry.ID, key, i)
+				}
+			}
+			if len(message.ParamTypes) != message.NumberOfArgs {
+				t.Errorf("Message %s.%s has %d param types for %d args", registry.ID, key, len(message.ParamTypes), message.NumberOfArgs)
+			}
+		}
+		prefixes[registry.RegistryPrefix] = true
+	}
+
+	for _, prefix := range []string{"Base", "TaskEvent", "ResourceEvent"} {
+		if !prefixes[prefix] {
+			t.Errorf("Missing bundled registry %s", prefix)
+		}
+	}
+}
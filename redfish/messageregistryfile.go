@@ -0,0 +1,126 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// MessageRegistryFileLocation shall contain the location information for a
+// registry file.
+type MessageRegistryFileLocation struct {
+	// ArchiveFile shall contain the file name of the individual registry file
+	// within the archive file specified by the ArchiveURI property.
+	ArchiveFile string
+	// ArchiveURI shall contain a URI that is colocated with the Redfish
+	// service that specifies the location of the registry file, which can be
+	// retrieved using the Redfish protocol and authentication methods. This
+	// property shall be used for only ZIP or other archive files.
+	ArchiveURI string `json:"ArchiveUri"`
+	// Language shall contain an RFC5646-conformant language code or 'default'.
+	Language string
+	// PublicationURI shall contain a URI not colocated with the Redfish
+	// service that specifies the canonical location of the registry file.
+	PublicationURI string `json:"PublicationUri"`
+	// URI shall contain a URI colocated with the Redfish service that
+	// specifies the location of the registry file, which can be retrieved
+	// using the Redfish protocol and authentication methods.
+	URI string `json:"Uri"`
+}
+
+// MessageRegistryFile shall represent the registry file locator for Redfish
+// implementations.
+type MessageRegistryFile struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// Languages shall contain a string consisting of an RFC5646 language
+	// code for each language the registry file is available in.
+	Languages []string
+	// Location shall contain the location information for this registry
+	// file.
+	Location []MessageRegistryFileLocation
+	// Registry shall contain the registry name and it major and minor
+	// version, as defined by the Redfish Specification.
+	Registry string
+}
+
+// GetMessageRegistryFile will get a MessageRegistryFile instance from the service.
+func GetMessageRegistryFile(c common.Client, uri string) (*MessageRegistryFile, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var messageregistryfile MessageRegistryFile
+	err = json.NewDecoder(resp.Body).Decode(&messageregistryfile)
+	if err != nil {
+		return nil, err
+	}
+
+	messageregistryfile.SetClient(c)
+	return &messageregistryfile, nil
+}
+
+// ListReferencedMessageRegistryFiles gets the collection of MessageRegistryFile
+// from a provided reference.
+func ListReferencedMessageRegistryFiles(c common.Client, link string) ([]*MessageRegistryFile, error) {
+	var result []*MessageRegistryFile
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, messageregistryfileLink := range links.ItemLinks {
+		messageregistryfile, err := GetMessageRegistryFile(c, messageregistryfileLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, messageregistryfile)
+	}
+
+	return result, nil
+}
+
+// MessageRegistry gets the registry described by this file, preferring the
+// given language and falling back to the first location with a URI on the
+// service.
+func (messageregistryfile *MessageRegistryFile) MessageRegistry(language string) (*MessageRegistry, error) {
+	uri := ""
+	for _, location := range messageregistryfile.Location {
+		if location.URI == "" {
+			continue
+		}
+		if location.Language == language {
+			uri = location.URI
+			break
+		}
+		if uri == "" {
+			uri = location.URI
+		}
+	}
+
+	if uri == "" {
+		return nil, nil
+	}
+
+	return GetMessageRegistry(messageregistryfile.Client, uri)
+}
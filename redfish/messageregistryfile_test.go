@@ -0,0 +1,57 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var messageRegistryFileBody = `{
+		"@odata.context": "/redfish/v1/$metadata#MessageRegistryFile.MessageRegistryFile",
+		"@odata.type": "#MessageRegistryFile.v1_1_0.MessageRegistryFile",
+		"@odata.id": "/redfish/v1/Registries/Base.1.4",
+		"Id": "Base.1.4",
+		"Name": "Base Message Registry File",
+		"Description": "Base Message Registry File locations",
+		"Languages": [
+			"en"
+		],
+		"Registry": "Base.1.4",
+		"Location": [
+			{
+				"Language": "en",
+				"PublicationUri": "http://redfish.dmtf.org/registries/Base.1.4.0.json",
+				"Uri": "/redfish/v1/Registries/Base.1.4/Base.1.4.0.json"
+			}
+		]
+	}`
+
+// TestMessageRegistryFile tests the parsing of MessageRegistryFile objects.
+func TestMessageRegistryFile(t *testing.T) {
+	var result MessageRegistryFile
+	err := json.NewDecoder(strings.NewReader(messageRegistryFileBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "Base.1.4" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.Registry != "Base.1.4" {
+		t.Errorf("Invalid registry: %s", result.Registry)
+	}
+
+	if result.Location[0].URI != "/redfish/v1/Registries/Base.1.4/Base.1.4.0.json" {
+		t.Errorf("Invalid location URI: %s", result.Location[0].URI)
+	}
+
+	if result.Location[0].PublicationURI != "http://redfish.dmtf.org/registries/Base.1.4.0.json" {
+		t.Errorf("Invalid publication URI: %s", result.Location[0].PublicationURI)
+	}
+}
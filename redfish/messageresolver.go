@@ -0,0 +1,220 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// ResolvedMessage is a message looked up in its registry with the message
+// arguments substituted.
+type ResolvedMessage struct {
+	// MessageID is the identifier the message was resolved from.
+	MessageID string
+	// Message is the rendered message text.
+	Message string
+	// Severity is the severity of the message.
+	Severity common.Health
+	// Resolution is the recommended action to resolve the condition.
+	Resolution string
+	// Registry is the Id of the registry the message was found in.
+	Registry string
+}
+
+// MessageResolver turns MessageIds and their arguments into readable
+// messages. Registries are fetched from the service on first use and cached,
+// falling back to the bundled standard registries when the service does not
+// provide them. A MessageResolver is safe for concurrent use.
+type MessageResolver struct {
+	// Language is the preferred language of the registries fetched from the
+	// service. The default is "en".
+	Language string
+
+	client     common.Client
+	registries string
+
+	mutex       sync.Mutex
+	files       []*MessageRegistryFile
+	filesLoaded bool
+	cache       map[string]*MessageRegistry
+	bundled     []*MessageRegistry
+}
+
+// NewMessageResolver creates a resolver for the registries referenced by the
+// given link, typically the Registries link of the service root. If the
+// client is nil or the link is empty, only bundled and added registries are
+// used.
+func NewMessageResolver(c common.Client, registries string) *MessageResolver {
+	return &MessageResolver{
+		Language:   "en",
+		client:     c,
+		registries: registries,
+		cache:      make(map[string]*MessageRegistry),
+	}
+}
+
+// AddRegistry adds a registry to the resolver, taking precedence over the
+// registries from the service and the bundled registries.
+func (resolver *MessageResolver) AddRegistry(registry *MessageRegistry) {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	prefix, major, minor := splitRegistryVersion(registry.RegistryPrefix + "." + registry.RegistryVersion)
+	resolver.cache[prefix+"."+major+"."+minor] = registry
+}
+
+// Resolve looks up the message with the given MessageId and substitutes the
+// message arguments.
+func (resolver *MessageResolver) Resolve(messageID string, args ...string) (*ResolvedMessage, error) {
+	index := strings.LastIndex(messageID, ".")
+	if index < 0 {
+		return nil, fmt.Errorf("invalid MessageId: %s", messageID)
+	}
+	key := messageID[index+1:]
+
+	prefix, major, minor := splitRegistryVersion(messageID[:index])
+	if major == "" {
+		return nil, fmt.Errorf("invalid MessageId: %s", messageID)
+	}
+
+	registry, err := resolver.registry(prefix, major, minor)
+	if err != nil {
+		return nil, err
+	}
+
+	message, ok := registry.Messages[key]
+	if !ok {
+		return nil, fmt.Errorf("message %s not found in registry %s", key, registry.ID)
+	}
+
+	return &ResolvedMessage{
+		MessageID:  messageID,
+		Message:    message.Render(args),
+		Severity:   message.severity(),
+		Resolution: message.Resolution,
+		Registry:   registry.ID,
+	}, nil
+}
+
+// registry gets the registry for the given prefix and version, first from
+// the cache, then the service, then the bundled registries.
+func (resolver *MessageResolver) registry(prefix, major, minor string) (*MessageRegistry, error) {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	name := prefix + "." + major + "." + minor
+	if registry, ok := resolver.cache[name]; ok {
+		return registry, nil
+	}
+
+	registry, serviceErr := resolver.serviceRegistry(name)
+	if registry == nil {
+		var err error
+		registry, err = resolver.bundledRegistry(prefix, major)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if registry == nil {
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+		return nil, fmt.Errorf("no message registry found for %s", name)
+	}
+
+	resolver.cache[name] = registry
+	return registry, nil
+}
+
+// serviceRegistry fetches the named registry from the service.
+func (resolver *MessageResolver) serviceRegistry(name string) (*MessageRegistry, error) {
+	if resolver.client == nil || resolver.registries == "" {
+		return nil, nil
+	}
+
+	if !resolver.filesLoaded {
+		files, err := ListReferencedMessageRegistryFiles(resolver.client, resolver.registries)
+		if err != nil {
+			return nil, err
+		}
+		resolver.files = files
+		resolver.filesLoaded = true
+	}
+
+	for _, file := range resolver.files {
+		if file.Registry != name && !strings.HasPrefix(file.Registry, name+".") {
+			continue
+		}
+		return file.MessageRegistry(resolver.Language)
+	}
+
+	return nil, nil
+}
+
+// bundledRegistry gets the bundled registry with the given prefix and major
+// version. Minor versions only add messages, so the newest is used.
+func (resolver *MessageResolver) bundledRegistry(prefix, major string) (*MessageRegistry, error) {
+	if resolver.bundled == nil {
+		bundled, err := BundledMessageRegistries()
+		if err != nil {
+			return nil, err
+		}
+		resolver.bundled = bundled
+	}
+
+	var result *MessageRegistry
+	for _, registry := range resolver.bundled {
+		registryPrefix, registryMajor, _ := splitRegistryVersion(registry.RegistryPrefix + "." + registry.RegistryVersion)
+		if registryPrefix != prefix || registryMajor != major {
+			continue
+		}
+		if result == nil || compareRegistryVersions(registry.RegistryVersion, result.RegistryVersion) > 0 {
+			result = registry
+		}
+	}
+
+	return result, nil
+}
+
+// splitRegistryVersion splits a registry name such as "Base.1.4" or
+// "Base.1.4.0" into its prefix and major and minor versions.
+func splitRegistryVersion(name string) (prefix, major, minor string) {
+	parts := strings.Split(name, ".")
+	prefix = parts[0]
+	if len(parts) > 1 {
+		major = parts[1]
+	}
+	if len(parts) > 2 {
+		minor = parts[2]
+	}
+	return prefix, major, minor
+}
+
+// compareRegistryVersions compares two dotted registry versions numerically.
+func compareRegistryVersions(a, b string) int {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		var x, y int
+		if i < len(partsA) {
+			fmt.Sscan(partsA[i], &x)
+		}
+		if i < len(partsB) {
+			fmt.Sscan(partsB[i], &y)
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,115 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// TestMessageResolverService tests resolving messages from a registry
+// hosted by the service, and that the registry is cached.
+func TestMessageResolverService(t *testing.T) {
+	client := &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/Registries": `{
+				"Members@odata.count": 1,
+				"Members": [{"@odata.id": "/redfish/v1/Registries/Base.1.4"}]
+			}`,
+			"GET /redfish/v1/Registries/Base.1.4":                 messageRegistryFileBody,
+			"GET /redfish/v1/Registries/Base.1.4/Base.1.4.0.json": messageRegistryBody,
+		},
+	}
+
+	resolver := NewMessageResolver(client, "/redfish/v1/Registries")
+	for i := 0; i < 2; i++ {
+		result, err := resolver.Resolve("Base.1.4.PropertyValueNotInList", "Blinking", "IndicatorLED")
+		if err != nil {
+			t.Fatalf("Error resolving message: %s", err)
+		}
+
+		if result.Message != "The value Blinking for the property IndicatorLED is not in the list of acceptable values." {
+			t.Errorf("Invalid message: %s", result.Message)
+		}
+
+		if result.Severity != common.WarningHealth {
+			t.Errorf("Invalid severity: %s", result.Severity)
+		}
+
+		if result.Registry != "Base.1.4.0" {
+			t.Errorf("Message resolved from the wrong registry: %s", result.Registry)
+		}
+	}
+
+	if len(client.calls) != 3 {
+		t.Errorf("Expected the registry to be fetched once, got %d calls", len(client.calls))
+	}
+}
+
+// TestMessageResolverBundled tests falling back to the bundled registries.
+func TestMessageResolverBundled(t *testing.T) {
+	resolver := NewMessageResolver(nil, "")
+
+	result, err := resolver.Resolve("TaskEvent.1.0.TaskCompletedWarning", "42")
+	if err != nil {
+		t.Fatalf("Error resolving message: %s", err)
+	}
+
+	if result.Message != "The task with Id '42' has completed with warnings." {
+		t.Errorf("Invalid message: %s", result.Message)
+	}
+
+	if result.Severity != common.WarningHealth {
+		t.Errorf("Invalid severity: %s", result.Severity)
+	}
+
+	result, err = resolver.Resolve("Base.1.0.0.ResourceMissingAtURI", "/redfish/v1/image.bin")
+	if err != nil {
+		t.Fatalf("Error resolving message: %s", err)
+	}
+
+	if result.Resolution != "Place a valid resource at the URI or correct the URI and resubmit the request." {
+		t.Errorf("Invalid resolution: %s", result.Resolution)
+	}
+
+	_, err = resolver.Resolve("Oem.1.0.Unknown")
+	if err == nil {
+		t.Error("Expected an error for an unknown registry")
+	}
+
+	_, err = resolver.Resolve("Base.1.4.NoSuchMessage")
+	if err == nil {
+		t.Error("Expected an error for an unknown message")
+	}
+}
+
+// TestMessageResolverAddRegistry tests that added registries take
+// precedence.
+func TestMessageResolverAddRegistry(t *testing.T) {
+	resolver := NewMessageResolver(nil, "")
+	resolver.AddRegistry(&MessageRegistry{
+		Entity:          common.Entity{ID: "Contoso.1.0.0"},
+		RegistryPrefix:  "Contoso",
+		RegistryVersion: "1.0.0",
+		Messages: map[string]MessageRegistryMessage{
+			"FanFailed": {
+				Message:         "Fan %1 has failed.",
+				MessageSeverity: common.CriticalHealth,
+				NumberOfArgs:    1,
+				Resolution:      "Replace the fan.",
+			},
+		},
+	})
+
+	result, err := resolver.Resolve("Contoso.1.0.FanFailed", "3")
+	if err != nil {
+		t.Fatalf("Error resolving message: %s", err)
+	}
+
+	if result.Message != "Fan 3 has failed." || result.Severity != common.CriticalHealth {
+		t.Errorf("Invalid resolved message: %s (%s)", result.Message, result.Severity)
+	}
+}
@@ -0,0 +1,187 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// MetricDataType is the data type of a metric.
+type MetricDataType string
+
+const (
+	// BooleanMetricDataType The JSON boolean definition.
+	BooleanMetricDataType MetricDataType = "Boolean"
+	// DateTimeMetricDataType The JSON string definition with the date-time
+	// format.
+	DateTimeMetricDataType MetricDataType = "DateTime"
+	// DecimalMetricDataType The JSON decimal definition.
+	DecimalMetricDataType MetricDataType = "Decimal"
+	// IntegerMetricDataType The JSON integer definition.
+	IntegerMetricDataType MetricDataType = "Integer"
+	// StringMetricDataType The JSON string definition.
+	StringMetricDataType MetricDataType = "String"
+	// EnumerationMetricDataType The JSON string definition with a set of
+	// defined enumerations.
+	EnumerationMetricDataType MetricDataType = "Enumeration"
+)
+
+// MetricType is the type of metric.
+type MetricType string
+
+const (
+	// NumericMetricType The metric is a numeric metric. The metric value is
+	// any real number.
+	NumericMetricType MetricType = "Numeric"
+	// DiscreteMetricType The metric is a discrete metric. The metric value is
+	// discrete.
+	DiscreteMetricType MetricType = "Discrete"
+	// GaugeMetricType The metric is a gauge metric. The metric value is a
+	// real number.
+	GaugeMetricType MetricType = "Gauge"
+	// CounterMetricType The metric is a counter metric. The metric value is
+	// a monotonically increasing value.
+	CounterMetricType MetricType = "Counter"
+	// CountdownMetricType The metric is a countdown metric. The metric value
+	// is a monotonically decreasing value.
+	CountdownMetricType MetricType = "Countdown"
+)
+
+// ImplementationType is the implementation of a metric.
+type ImplementationType string
+
+const (
+	// PhysicalSensorImplementationType The metric is implemented as a
+	// physical sensor.
+	PhysicalSensorImplementationType ImplementationType = "PhysicalSensor"
+	// CalculatedImplementationType The metric is implemented by applying a
+	// calculation on another metric property.
+	CalculatedImplementationType ImplementationType = "Calculated"
+	// SynthesizedImplementationType The metric is implemented by applying a
+	// calculation on one or more metric properties.
+	SynthesizedImplementationType ImplementationType = "Synthesized"
+	// DigitalMeterImplementationType The metric is implemented as digital
+	// meter.
+	DigitalMeterImplementationType ImplementationType = "DigitalMeter"
+)
+
+// Calculable is the types of calculations that can be applied to a metric.
+type Calculable string
+
+const (
+	// NonCalculatableCalculable No calculations should be performed on the
+	// metric reading.
+	NonCalculatableCalculable Calculable = "NonCalculatable"
+	// SummableCalculable The sum of the metric reading across multiple
+	// instances is meaningful.
+	SummableCalculable Calculable = "Summable"
+	// NonSummableCalculable The sum of the metric reading across multiple
+	// instances is not meaningful.
+	NonSummableCalculable Calculable = "NonSummable"
+)
+
+// MetricDefinition shall define the metadata information about a metric.
+type MetricDefinition struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Accuracy shall contain the percent error +/- of the measured versus
+	// actual values of the metric.
+	Accuracy float32
+	// Calculable shall specify whether the metric can be used in a
+	// calculation.
+	Calculable Calculable
+	// CalculationAlgorithm shall contain the calculation performed to obtain
+	// the metric.
+	CalculationAlgorithm string
+	// Description provides a description of this resource.
+	Description string
+	// DiscreteValues shall specify the possible values of the discrete
+	// metric.
+	DiscreteValues []string
+	// Implementation shall specify the implementation of the metric.
+	Implementation ImplementationType
+	// IsLinear shall indicate whether the metric values are linear versus
+	// non-linear.
+	IsLinear bool
+	// MaxReadingRange shall contain the maximum possible value of the metric.
+	MaxReadingRange float32
+	// MetricDataType shall specify the data type of the metric.
+	MetricDataType MetricDataType
+	// MetricProperties shall contain a list of URIs with wildcards and
+	// property identifiers for which this metric definition is defined.
+	MetricProperties []string
+	// MetricType shall specify the type of metric.
+	MetricType MetricType
+	// MinReadingRange shall contain the minimum possible value of the metric.
+	MinReadingRange float32
+	// PhysicalContext shall contain the physical context of the metric.
+	PhysicalContext string
+	// Precision shall specify the number of significant digits in the metric
+	// reading.
+	Precision int
+	// SensingInterval shall specify the time interval between when a metric
+	// is updated.
+	SensingInterval string
+	// TimestampAccuracy shall specify the expected and +/- accuracy of the
+	// timestamp.
+	TimestampAccuracy string
+	// Units shall specify the units of the metric.
+	Units string
+	// Wildcards shall contain a list of wildcards and their substitution
+	// values to apply to the metric properties.
+	Wildcards []Wildcard
+}
+
+// GetMetricDefinition will get a MetricDefinition instance from the service.
+func GetMetricDefinition(c common.Client, uri string) (*MetricDefinition, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var metricdefinition MetricDefinition
+	err = json.NewDecoder(resp.Body).Decode(&metricdefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	metricdefinition.SetClient(c)
+	return &metricdefinition, nil
+}
+
+// ListReferencedMetricDefinitions gets the collection of MetricDefinition from
+// a provided reference.
+func ListReferencedMetricDefinitions(c common.Client, link string) ([]*MetricDefinition, error) {
+	var result []*MetricDefinition
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, metricdefinitionLink := range links.ItemLinks {
+		metricdefinition, err := GetMetricDefinition(c, metricdefinitionLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, metricdefinition)
+	}
+
+	return result, nil
+}
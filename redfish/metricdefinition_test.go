@@ -0,0 +1,73 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var metricDefinitionBody = strings.NewReader(
+	`{
+		"@odata.context": "/redfish/v1/$metadata#MetricDefinition.MetricDefinition",
+		"@odata.type": "#MetricDefinition.v1_0_3.MetricDefinition",
+		"@odata.id": "/redfish/v1/TelemetryService/MetricDefinitions/PowerConsumedWatts",
+		"Id": "PowerConsumedWatts",
+		"Name": "Power Consumed Watts Metric Definition",
+		"MetricType": "Numeric",
+		"Implementation": "PhysicalSensor",
+		"PhysicalContext": "PowerSupply",
+		"MetricDataType": "Decimal",
+		"Units": "W",
+		"Precision": 4,
+		"Accuracy": 1,
+		"Calibration": 2,
+		"MinReadingRange": 0,
+		"MaxReadingRange": 1000,
+		"SensingInterval": "PT1S",
+		"TimestampAccuracy": "PT1S",
+		"Wildcards": [
+			{
+				"Name": "ChassisID",
+				"Values": [
+					"1"
+				]
+			}
+		],
+		"MetricProperties": [
+			"/redfish/v1/Chassis/{ChassisID}/Power#/PowerControl/0/PowerConsumedWatts"
+		]
+	}`)
+
+// TestMetricDefinition tests the parsing of MetricDefinition objects.
+func TestMetricDefinition(t *testing.T) {
+	var result MetricDefinition
+	err := json.NewDecoder(metricDefinitionBody).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "PowerConsumedWatts" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.MetricType != NumericMetricType {
+		t.Errorf("Invalid metric type: %s", result.MetricType)
+	}
+
+	if result.MetricDataType != DecimalMetricDataType {
+		t.Errorf("Invalid metric data type: %s", result.MetricDataType)
+	}
+
+	if result.MaxReadingRange != 1000 {
+		t.Errorf("Invalid max reading range: %f", result.MaxReadingRange)
+	}
+
+	if result.Wildcards[0].Name != "ChassisID" || result.Wildcards[0].Values[0] != "1" {
+		t.Errorf("Invalid wildcards: %v", result.Wildcards)
+	}
+}
@@ -0,0 +1,160 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// MetricValue shall contain properties that capture a metric value and other
+// associated information.
+type MetricValue struct {
+	// metricDefinition shall contain a link to the metric definition for
+	// this metric.
+	metricDefinition string
+	// MetricID shall contain the same value as the Id property of the source
+	// metric within the associated metric definition.
+	MetricID string `json:"MetricId"`
+	// MetricProperty shall contain a URI following RFC6901-specified JSON
+	// pointer notation to the property from which this metric is derived.
+	MetricProperty string
+	// MetricValue shall contain the metric value, as a string.
+	MetricValue string
+	// Timestamp shall contain the time when the metric value was obtained.
+	Timestamp string
+}
+
+// UnmarshalJSON unmarshals a MetricValue object from the raw JSON.
+func (metricvalue *MetricValue) UnmarshalJSON(b []byte) error {
+	type temp MetricValue
+	var t struct {
+		temp
+		MetricDefinition common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*metricvalue = MetricValue(t.temp)
+
+	// Extract the links to other entities for later
+	metricvalue.metricDefinition = string(t.MetricDefinition)
+
+	return nil
+}
+
+// MetricReport shall contain a set of collected metrics.
+type MetricReport struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Context shall contain a client supplied context for the event
+	// destination to which this metric report is sent.
+	Context string
+	// Description provides a description of this resource.
+	Description string
+	// MetricValues shall be metric values for this metric report.
+	MetricValues []MetricValue
+	// ReportSequence shall contain the current sequence identifier for this
+	// metric report.
+	ReportSequence string
+	// Timestamp shall contain the time when the metric report was generated.
+	Timestamp string
+	// metricReportDefinition shall contain a link to the definition of this
+	// metric report.
+	metricReportDefinition string
+}
+
+// UnmarshalJSON unmarshals a MetricReport object from the raw JSON.
+func (metricreport *MetricReport) UnmarshalJSON(b []byte) error {
+	type temp MetricReport
+	var t struct {
+		temp
+		MetricReportDefinition common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*metricreport = MetricReport(t.temp)
+
+	// Extract the links to other entities for later
+	metricreport.metricReportDefinition = string(t.MetricReportDefinition)
+
+	return nil
+}
+
+// GetMetricReport will get a MetricReport instance from the service.
+func GetMetricReport(c common.Client, uri string) (*MetricReport, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var metricreport MetricReport
+	err = json.NewDecoder(resp.Body).Decode(&metricreport)
+	if err != nil {
+		return nil, err
+	}
+
+	metricreport.SetClient(c)
+	return &metricreport, nil
+}
+
+// ListReferencedMetricReports gets the collection of MetricReport from
+// a provided reference.
+func ListReferencedMetricReports(c common.Client, link string) ([]*MetricReport, error) {
+	var result []*MetricReport
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, metricreportLink := range links.ItemLinks {
+		metricreport, err := GetMetricReport(c, metricreportLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, metricreport)
+	}
+
+	return result, nil
+}
+
+// MetricReportDefinition gets the definition of this metric report.
+func (metricreport *MetricReport) MetricReportDefinition() (*MetricReportDefinition, error) {
+	if metricreport.metricReportDefinition == "" {
+		return nil, nil
+	}
+
+	return GetMetricReportDefinition(metricreport.Client, metricreport.metricReportDefinition)
+}
+
+// MetricDefinition gets the definition of the metric this value belongs to.
+func (metricreport *MetricReport) MetricDefinition(value *MetricValue) (*MetricDefinition, error) {
+	if value.metricDefinition == "" {
+		return nil, nil
+	}
+
+	return GetMetricDefinition(metricreport.Client, value.metricDefinition)
+}
@@ -0,0 +1,81 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var metricReportBody = strings.NewReader(
+	`{
+		"@odata.context": "/redfish/v1/$metadata#MetricReport.MetricReport",
+		"@odata.type": "#MetricReport.v1_2_0.MetricReport",
+		"@odata.id": "/redfish/v1/TelemetryService/MetricReports/PowerMetrics",
+		"Id": "PowerMetrics",
+		"Name": "Power Metrics Report",
+		"ReportSequence": "127",
+		"Timestamp": "2020-06-01T12:01:00Z",
+		"MetricReportDefinition": {
+			"@odata.id": "/redfish/v1/TelemetryService/MetricReportDefinitions/PowerMetrics"
+		},
+		"MetricValues": [
+			{
+				"MetricId": "AverageConsumedWatts",
+				"MetricValue": "100",
+				"Timestamp": "2020-06-01T12:00:00Z",
+				"MetricProperty": "/redfish/v1/Chassis/1/Power#/PowerControl/0/PowerConsumedWatts",
+				"MetricDefinition": {
+					"@odata.id": "/redfish/v1/TelemetryService/MetricDefinitions/PowerConsumedWatts"
+				}
+			},
+			{
+				"MetricId": "AverageConsumedWatts",
+				"MetricValue": "94",
+				"Timestamp": "2020-06-01T12:00:00Z",
+				"MetricProperty": "/redfish/v1/Chassis/1/Power#/PowerControl/1/PowerConsumedWatts"
+			}
+		]
+	}`)
+
+// TestMetricReport tests the parsing of MetricReport objects.
+func TestMetricReport(t *testing.T) {
+	var result MetricReport
+	err := json.NewDecoder(metricReportBody).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "PowerMetrics" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.Timestamp != "2020-06-01T12:01:00Z" {
+		t.Errorf("Invalid timestamp: %s", result.Timestamp)
+	}
+
+	if result.metricReportDefinition != "/redfish/v1/TelemetryService/MetricReportDefinitions/PowerMetrics" {
+		t.Errorf("Invalid metric report definition link: %s", result.metricReportDefinition)
+	}
+
+	if len(result.MetricValues) != 2 {
+		t.Fatalf("Expected 2 metric values, got %d", len(result.MetricValues))
+	}
+
+	value := result.MetricValues[1]
+	if value.MetricValue != "94" || value.Timestamp != "2020-06-01T12:00:00Z" {
+		t.Errorf("Invalid metric value: %s at %s", value.MetricValue, value.Timestamp)
+	}
+
+	if value.MetricProperty != "/redfish/v1/Chassis/1/Power#/PowerControl/1/PowerConsumedWatts" {
+		t.Errorf("Invalid metric property: %s", value.MetricProperty)
+	}
+
+	if result.MetricValues[0].metricDefinition != "/redfish/v1/TelemetryService/MetricDefinitions/PowerConsumedWatts" {
+		t.Errorf("Invalid metric definition link: %s", result.MetricValues[0].metricDefinition)
+	}
+}
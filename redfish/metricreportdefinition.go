@@ -0,0 +1,233 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// MetricReportDefinitionType is when the metric report is generated.
+type MetricReportDefinitionType string
+
+const (
+	// PeriodicMetricReportDefinitionType The service shall generate the
+	// metric report at the interval given by the Schedule.
+	PeriodicMetricReportDefinitionType MetricReportDefinitionType = "Periodic"
+	// OnChangeMetricReportDefinitionType The service shall generate the
+	// metric report when any of the metric values change.
+	OnChangeMetricReportDefinitionType MetricReportDefinitionType = "OnChange"
+	// OnRequestMetricReportDefinitionType The service shall generate the
+	// metric report when a client requests it.
+	OnRequestMetricReportDefinitionType MetricReportDefinitionType = "OnRequest"
+)
+
+// ReportActionsEnum is the action to take when a metric report is generated.
+type ReportActionsEnum string
+
+const (
+	// LogToMetricReportsCollectionReportActionsEnum The service shall
+	// record the occurrence to the metric report collection.
+	LogToMetricReportsCollectionReportActionsEnum ReportActionsEnum = "LogToMetricReportsCollection"
+	// RedfishEventReportActionsEnum The service shall send a Redfish event
+	// message containing the metric report.
+	RedfishEventReportActionsEnum ReportActionsEnum = "RedfishEvent"
+)
+
+// ReportUpdatesEnum is how subsequent metric reports are handled.
+type ReportUpdatesEnum string
+
+const (
+	// OverwriteReportUpdatesEnum The service shall overwrite the metric
+	// report.
+	OverwriteReportUpdatesEnum ReportUpdatesEnum = "Overwrite"
+	// AppendWrapsWhenFullReportUpdatesEnum The service shall append new
+	// information to the metric report, overwriting the oldest entries when
+	// full.
+	AppendWrapsWhenFullReportUpdatesEnum ReportUpdatesEnum = "AppendWrapsWhenFull"
+	// AppendStopsWhenFullReportUpdatesEnum The service shall append new
+	// information to the metric report, and stop adding entries when full.
+	AppendStopsWhenFullReportUpdatesEnum ReportUpdatesEnum = "AppendStopsWhenFull"
+	// NewReportReportUpdatesEnum The service shall create a new metric
+	// report resource for each update.
+	NewReportReportUpdatesEnum ReportUpdatesEnum = "NewReport"
+)
+
+// CollectionTimeScope is the scope of time over which a metric is collected.
+type CollectionTimeScope string
+
+const (
+	// PointCollectionTimeScope The corresponding metric values apply to a
+	// point in time.
+	PointCollectionTimeScope CollectionTimeScope = "Point"
+	// IntervalCollectionTimeScope The corresponding metric values apply to a
+	// time interval.
+	IntervalCollectionTimeScope CollectionTimeScope = "Interval"
+	// StartupIntervalCollectionTimeScope The corresponding metric values
+	// apply to a time interval that began on the startup of the measured
+	// resource.
+	StartupIntervalCollectionTimeScope CollectionTimeScope = "StartupInterval"
+)
+
+// Metric shall specify a set of metrics and the calculation applied to them.
+type Metric struct {
+	// CollectionDuration shall specify the duration over which the function
+	// is computed.
+	CollectionDuration string `json:",omitempty"`
+	// CollectionFunction shall specify the function to perform on each of
+	// the metric properties listed in the MetricProperties property.
+	CollectionFunction CollectionFunction `json:",omitempty"`
+	// CollectionTimeScope shall specify the scope of time over which the
+	// function is applied.
+	CollectionTimeScope CollectionTimeScope `json:",omitempty"`
+	// MetricID shall specify the label for the metric definition that is
+	// derived by applying the collection function to the metric property.
+	MetricID string `json:"MetricId,omitempty"`
+	// MetricProperties shall specify the URIs of the properties that are
+	// captured by the metric.
+	MetricProperties []string `json:",omitempty"`
+}
+
+// MetricReportDefinition shall specify a set of metrics to be collected into
+// a metric report.
+type MetricReportDefinition struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// AppendLimit shall indicate the maximum number of entries that can be
+	// appended to a metric report.
+	AppendLimit int
+	// Description provides a description of this resource.
+	Description string
+	// MetricProperties shall specify a list of URIs with wildcards and
+	// property identifiers to include in the metric report.
+	MetricProperties []string
+	// MetricReportDefinitionEnabled shall indicate whether the generation of
+	// new metric reports is enabled.
+	MetricReportDefinitionEnabled bool
+	// MetricReportDefinitionType shall specify when the metric report is
+	// generated.
+	MetricReportDefinitionType MetricReportDefinitionType
+	// MetricReportHeartbeatInterval shall indicate the interval at which an
+	// event containing the metric report is sent even if nothing changed.
+	MetricReportHeartbeatInterval string
+	// Metrics shall specify a list of metrics to include in the metric
+	// report.
+	Metrics []Metric
+	// ReportActions shall specify the actions to perform when a metric report
+	// is generated.
+	ReportActions []ReportActionsEnum
+	// ReportTimespan shall indicate the maximum timespan that a metric report
+	// can cover.
+	ReportTimespan string
+	// ReportUpdates shall specify how subsequent metric reports are handled.
+	ReportUpdates ReportUpdatesEnum
+	// Schedule shall specify the schedule for generating the metric report,
+	// if the MetricReportDefinitionType is Periodic.
+	Schedule common.Schedule
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// SuppressRepeatedMetricValue shall indicate whether any metrics are
+	// suppressed from the generated metric report if they have not changed.
+	SuppressRepeatedMetricValue bool
+	// Wildcards shall contain a set of wildcards and their replacement
+	// strings, which are applied to the MetricProperties property.
+	Wildcards []Wildcard
+	// metricReport shall contain a link to the most recent metric report
+	// generated by this definition.
+	metricReport string
+}
+
+// UnmarshalJSON unmarshals a MetricReportDefinition object from the raw JSON.
+func (metricreportdefinition *MetricReportDefinition) UnmarshalJSON(b []byte) error {
+	type temp MetricReportDefinition
+	var t struct {
+		temp
+		MetricReport common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*metricreportdefinition = MetricReportDefinition(t.temp)
+
+	// Extract the links to other entities for later
+	metricreportdefinition.metricReport = string(t.MetricReport)
+
+	return nil
+}
+
+// GetMetricReportDefinition will get a MetricReportDefinition instance from the service.
+func GetMetricReportDefinition(c common.Client, uri string) (*MetricReportDefinition, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var metricreportdefinition MetricReportDefinition
+	err = json.NewDecoder(resp.Body).Decode(&metricreportdefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	metricreportdefinition.SetClient(c)
+	return &metricreportdefinition, nil
+}
+
+// ListReferencedMetricReportDefinitions gets the collection of
+// MetricReportDefinition from a provided reference.
+func ListReferencedMetricReportDefinitions(c common.Client, link string) ([]*MetricReportDefinition, error) {
+	var result []*MetricReportDefinition
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, metricreportdefinitionLink := range links.ItemLinks {
+		metricreportdefinition, err := GetMetricReportDefinition(c, metricreportdefinitionLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, metricreportdefinition)
+	}
+
+	return result, nil
+}
+
+// MetricReport gets the most recent metric report generated by this
+// definition.
+func (metricreportdefinition *MetricReportDefinition) MetricReport() (*MetricReport, error) {
+	if metricreportdefinition.metricReport == "" {
+		return nil, nil
+	}
+
+	return GetMetricReport(metricreportdefinition.Client, metricreportdefinition.metricReport)
+}
+
+// Delete removes the metric report definition from the service.
+func (metricreportdefinition *MetricReportDefinition) Delete() error {
+	resp, err := metricreportdefinition.Client.Delete(metricreportdefinition.ODataID)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,101 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var metricReportDefinitionBody = `{
+		"@odata.context": "/redfish/v1/$metadata#MetricReportDefinition.MetricReportDefinition",
+		"@odata.type": "#MetricReportDefinition.v1_3_0.MetricReportDefinition",
+		"@odata.id": "/redfish/v1/TelemetryService/MetricReportDefinitions/PowerMetrics",
+		"Id": "PowerMetrics",
+		"Name": "Transmit and Log Power Metrics",
+		"MetricReportDefinitionType": "Periodic",
+		"MetricReportDefinitionEnabled": true,
+		"Schedule": {
+			"RecurrenceInterval": "PT1M"
+		},
+		"ReportActions": [
+			"RedfishEvent",
+			"LogToMetricReportsCollection"
+		],
+		"ReportUpdates": "Overwrite",
+		"MetricReport": {
+			"@odata.id": "/redfish/v1/TelemetryService/MetricReports/PowerMetrics"
+		},
+		"Status": {
+			"State": "Enabled"
+		},
+		"Wildcards": [
+			{
+				"Name": "PWild",
+				"Values": [
+					"0",
+					"1"
+				]
+			}
+		],
+		"Metrics": [
+			{
+				"MetricId": "AverageConsumedWatts",
+				"CollectionFunction": "Average",
+				"CollectionDuration": "PT1M",
+				"CollectionTimeScope": "Interval",
+				"MetricProperties": [
+					"/redfish/v1/Chassis/1/Power#/PowerControl/{PWild}/PowerConsumedWatts"
+				]
+			}
+		]
+	}`
+
+// TestMetricReportDefinition tests the parsing of MetricReportDefinition objects.
+func TestMetricReportDefinition(t *testing.T) {
+	var result MetricReportDefinition
+	err := json.NewDecoder(strings.NewReader(metricReportDefinitionBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "PowerMetrics" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.MetricReportDefinitionType != PeriodicMetricReportDefinitionType {
+		t.Errorf("Invalid definition type: %s", result.MetricReportDefinitionType)
+	}
+
+	if result.Schedule.RecurrenceInterval != "PT1M" {
+		t.Errorf("Invalid schedule: %s", result.Schedule.RecurrenceInterval)
+	}
+
+	if result.ReportActions[0] != RedfishEventReportActionsEnum {
+		t.Errorf("Invalid report action: %s", result.ReportActions[0])
+	}
+
+	if result.ReportUpdates != OverwriteReportUpdatesEnum {
+		t.Errorf("Invalid report updates: %s", result.ReportUpdates)
+	}
+
+	if result.Metrics[0].MetricID != "AverageConsumedWatts" {
+		t.Errorf("Invalid metric ID: %s", result.Metrics[0].MetricID)
+	}
+
+	if result.Metrics[0].CollectionTimeScope != IntervalCollectionTimeScope {
+		t.Errorf("Invalid collection time scope: %s", result.Metrics[0].CollectionTimeScope)
+	}
+
+	if len(result.Wildcards[0].Values) != 2 {
+		t.Errorf("Invalid wildcards: %v", result.Wildcards)
+	}
+
+	if result.metricReport != "/redfish/v1/TelemetryService/MetricReports/PowerMetrics" {
+		t.Errorf("Invalid metric report link: %s", result.metricReport)
+	}
+}
@@ -0,0 +1,252 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// PortLinkState is the desired link state of a port.
+type PortLinkState string
+
+const (
+	// EnabledPortLinkState The link is enabled and operational.
+	EnabledPortLinkState PortLinkState = "Enabled"
+	// DisabledPortLinkState The link is disabled and not operational.
+	DisabledPortLinkState PortLinkState = "Disabled"
+)
+
+// FabricLinkStatus is the link status of a fabric port.
+type FabricLinkStatus string
+
+const (
+	// LinkUpFabricLinkStatus This link on this interface is up.
+	LinkUpFabricLinkStatus FabricLinkStatus = "LinkUp"
+	// StartingFabricLinkStatus This link on this interface is starting. A
+	// physical link has been established, but the port is not able to
+	// transfer data.
+	StartingFabricLinkStatus FabricLinkStatus = "Starting"
+	// TrainingFabricLinkStatus This physical link on this interface is
+	// training.
+	TrainingFabricLinkStatus FabricLinkStatus = "Training"
+	// LinkDownFabricLinkStatus The link on this interface is down.
+	LinkDownFabricLinkStatus FabricLinkStatus = "LinkDown"
+	// NoLinkFabricLinkStatus No physical link detected on this interface.
+	NoLinkFabricLinkStatus FabricLinkStatus = "NoLink"
+)
+
+// PortMedium is the physical connection medium of a port.
+type PortMedium string
+
+const (
+	// ElectricalPortMedium This port has an electrical cable connection.
+	ElectricalPortMedium PortMedium = "Electrical"
+	// OpticalPortMedium This port has an optical cable connection.
+	OpticalPortMedium PortMedium = "Optical"
+)
+
+// PortType is the type of a port.
+type PortType string
+
+const (
+	// UpstreamPortPortType This port connects to a host device.
+	UpstreamPortPortType PortType = "UpstreamPort"
+	// DownstreamPortPortType This port connects to a target device.
+	DownstreamPortPortType PortType = "DownstreamPort"
+	// InterswitchPortPortType This port connects to another switch.
+	InterswitchPortPortType PortType = "InterswitchPort"
+	// ManagementPortPortType This port connects to a switch manager.
+	ManagementPortPortType PortType = "ManagementPort"
+	// BidirectionalPortPortType This port connects to any type of device.
+	BidirectionalPortPortType PortType = "BidirectionalPort"
+	// UnconfiguredPortPortType This port has not yet been configured.
+	UnconfiguredPortPortType PortType = "UnconfiguredPort"
+)
+
+// Port is used to represent a simple port for a Redfish implementation.
+type Port struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// CurrentSpeedGbps shall contain the unidirectional speed to which this
+	// port is currently configured to train.
+	CurrentSpeedGbps float32
+	// Description provides a description of this resource.
+	Description string
+	// InterfaceEnabled shall indicate whether this interface is enabled.
+	InterfaceEnabled bool
+	// LinkNetworkTechnology shall contain a network technology capability of
+	// this port.
+	LinkNetworkTechnology LinkNetworkTechnology
+	// LinkState shall contain the desired link state for this interface.
+	LinkState PortLinkState
+	// LinkStatus shall contain the desired link status for this interface.
+	LinkStatus FabricLinkStatus
+	// Location shall contain location information of the associated port.
+	Location common.Location
+	// MaxSpeedGbps shall contain the maximum frequency rate for each link
+	// for this port.
+	MaxSpeedGbps float32
+	// PortID shall contain the name of the port as indicated on the device
+	// containing the port.
+	PortID string `json:"PortId"`
+	// PortMedium shall contain the physical connection medium for this port.
+	PortMedium PortMedium
+	// PortProtocol shall contain the protocol being sent over this port.
+	PortProtocol common.Protocol
+	// PortType shall contain the port type for this port.
+	PortType PortType
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// Width shall contain the number of physical transport links that this
+	// port contains.
+	Width int
+	// associatedEndpoints shall contain an array of links to resources of
+	// type Endpoint that represent the endpoints to which this port is
+	// connected.
+	associatedEndpoints []string
+	// connectedPorts shall contain an array of links to resources of type
+	// Port that represent ports associated with this port.
+	connectedPorts []string
+	// connectedSwitchPorts shall contain an array of links to resources of
+	// type Port that represent the switch ports to which this port is
+	// connected.
+	connectedSwitchPorts []string
+	// connectedSwitches shall contain an array of links to resources of type
+	// Switch that represent the switches to which this port is connected.
+	connectedSwitches []string
+}
+
+// UnmarshalJSON unmarshals a Port object from the raw JSON.
+func (port *Port) UnmarshalJSON(b []byte) error {
+	type temp Port
+	type links struct {
+		AssociatedEndpoints  common.Links
+		ConnectedPorts       common.Links
+		ConnectedSwitchPorts common.Links
+		ConnectedSwitches    common.Links
+	}
+	var t struct {
+		temp
+		Links links
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*port = Port(t.temp)
+
+	// Extract the links to other entities for later
+	port.associatedEndpoints = t.Links.AssociatedEndpoints.ToStrings()
+	port.connectedPorts = t.Links.ConnectedPorts.ToStrings()
+	port.connectedSwitchPorts = t.Links.ConnectedSwitchPorts.ToStrings()
+	port.connectedSwitches = t.Links.ConnectedSwitches.ToStrings()
+
+	return nil
+}
+
+// GetPort will get a Port instance from the service.
+func GetPort(c common.Client, uri string) (*Port, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var port Port
+	err = json.NewDecoder(resp.Body).Decode(&port)
+	if err != nil {
+		return nil, err
+	}
+
+	port.SetClient(c)
+	return &port, nil
+}
+
+// ListReferencedPorts gets the collection of Port from
+// a provided reference.
+func ListReferencedPorts(c common.Client, link string) ([]*Port, error) {
+	var result []*Port
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, portLink := range links.ItemLinks {
+		port, err := GetPort(c, portLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, port)
+	}
+
+	return result, nil
+}
+
+// AssociatedEndpoints gets the endpoints this port is connected to.
+func (port *Port) AssociatedEndpoints() ([]*Endpoint, error) {
+	var result []*Endpoint
+	for _, link := range port.associatedEndpoints {
+		endpoint, err := GetEndpoint(port.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, endpoint)
+	}
+
+	return result, nil
+}
+
+// ConnectedPorts gets the ports associated with this port.
+func (port *Port) ConnectedPorts() ([]*Port, error) {
+	return port.getPorts(port.connectedPorts)
+}
+
+// ConnectedSwitchPorts gets the switch ports this port is connected to.
+func (port *Port) ConnectedSwitchPorts() ([]*Port, error) {
+	return port.getPorts(port.connectedSwitchPorts)
+}
+
+func (port *Port) getPorts(links []string) ([]*Port, error) {
+	var result []*Port
+	for _, link := range links {
+		p, err := GetPort(port.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, p)
+	}
+
+	return result, nil
+}
+
+// ConnectedSwitches gets the switches this port is connected to.
+func (port *Port) ConnectedSwitches() ([]*Switch, error) {
+	var result []*Switch
+	for _, link := range port.connectedSwitches {
+		s, err := GetSwitch(port.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
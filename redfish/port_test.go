@@ -0,0 +1,93 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+var portBody = strings.NewReader(
+	`{
+		"@odata.context": "/redfish/v1/$metadata#Port.Port",
+		"@odata.type": "#Port.v1_2_0.Port",
+		"@odata.id": "/redfish/v1/Fabrics/PCIe/Switches/1/Ports/Up1",
+		"Id": "Up1",
+		"Name": "PCIe Upstream Port 1",
+		"PortId": "1",
+		"PortProtocol": "PCIe",
+		"PortType": "UpstreamPort",
+		"PortMedium": "Electrical",
+		"CurrentSpeedGbps": 128,
+		"MaxSpeedGbps": 256,
+		"Width": 4,
+		"InterfaceEnabled": true,
+		"LinkState": "Enabled",
+		"LinkStatus": "LinkUp",
+		"Status": {
+			"State": "Enabled",
+			"Health": "OK"
+		},
+		"Links": {
+			"AssociatedEndpoints": [
+				{"@odata.id": "/redfish/v1/Fabrics/PCIe/Endpoints/Host1"}
+			],
+			"ConnectedSwitches": [
+				{"@odata.id": "/redfish/v1/Fabrics/PCIe/Switches/2"}
+			],
+			"ConnectedSwitchPorts": [
+				{"@odata.id": "/redfish/v1/Fabrics/PCIe/Switches/2/Ports/Down1"}
+			]
+		}
+	}`)
+
+// TestPort tests the parsing of Port objects.
+func TestPort(t *testing.T) {
+	var result Port
+	err := json.NewDecoder(portBody).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "Up1" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.PortID != "1" {
+		t.Errorf("Invalid port ID: %s", result.PortID)
+	}
+
+	if result.PortProtocol != common.PCIeProtocol {
+		t.Errorf("Invalid port protocol: %s", result.PortProtocol)
+	}
+
+	if result.PortType != UpstreamPortPortType {
+		t.Errorf("Invalid port type: %s", result.PortType)
+	}
+
+	if result.LinkStatus != LinkUpFabricLinkStatus {
+		t.Errorf("Invalid link status: %s", result.LinkStatus)
+	}
+
+	if result.CurrentSpeedGbps != 128 || result.Width != 4 {
+		t.Errorf("Invalid speed or width: %f %d", result.CurrentSpeedGbps, result.Width)
+	}
+
+	if result.associatedEndpoints[0] != "/redfish/v1/Fabrics/PCIe/Endpoints/Host1" {
+		t.Errorf("Invalid associated endpoints: %v", result.associatedEndpoints)
+	}
+
+	if result.connectedSwitches[0] != "/redfish/v1/Fabrics/PCIe/Switches/2" {
+		t.Errorf("Invalid connected switches: %v", result.connectedSwitches)
+	}
+
+	if result.connectedSwitchPorts[0] != "/redfish/v1/Fabrics/PCIe/Switches/2/Ports/Down1" {
+		t.Errorf("Invalid connected switch ports: %v", result.connectedSwitchPorts)
+	}
+}
@@ -6,6 +6,8 @@ package redfish
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
 
 	"github.com/rocksolidlabs/gofish/common"
 )
@@ -108,7 +110,9 @@ type InputRange struct {
 }
 
 // Power is used to represent a power metrics resource for a Redfish
-// implementation.
+// implementation. Readings, thresholds and limits are nil if the service
+// does not report them. Use common.Float32Value or common.Float32Or to read
+// them, or accessors such as Voltage.Volts for the main readings.
 type Power struct {
 	common.Entity
 
@@ -142,6 +146,11 @@ type Power struct {
 	Voltages []Voltage
 	// VoltagesCount is the number of objects.
 	VoltagesCount int `json:"Voltages@odata.count"`
+	// AllowUnconditionalPatch lets SetPowerLimit and ClearPowerLimit send
+	// the limit without If-Match when the service does not report an ETag
+	// for the resource. The limit may then overwrite changes made since the
+	// resource was read.
+	AllowUnconditionalPatch bool `json:"-"`
 }
 
 // GetPower will get a Power instance from the service.
@@ -158,6 +167,11 @@ func GetPower(c common.Client, uri string) (*Power, error) {
 		return nil, err
 	}
 
+	// Services may only report the ETag in the response header
+	if power.ODataEtag == "" {
+		power.ODataEtag = resp.Header.Get("ETag")
+	}
+
 	power.SetClient(c)
 	return &power, nil
 }
@@ -186,6 +200,146 @@ func ListReferencedPowers(c common.Client, link string) ([]*Power, error) {
 	return result, nil
 }
 
+// SetPowerLimit caps the power of the PowerControl with the given MemberId.
+// The limit must be positive and may not exceed the PowerCapacityWatts of
+// the PowerControl, if the service reports it. An empty exception or a
+// correction time of zero leaves the current setting. The request only
+// succeeds if the resource has not changed since it was read, so it fails
+// if the service does not report an ETag for the resource, unless
+// AllowUnconditionalPatch is set.
+func (power *Power) SetPowerLimit(memberID string, watts float32, exception PowerLimitException, correctionMs int64) error {
+	index, err := power.powerControlIndex(memberID)
+	if err != nil {
+		return err
+	}
+
+	control := &power.PowerControl[index]
+	if watts <= 0 {
+		return fmt.Errorf("power limit must be positive, got %g W", watts)
+	}
+	if capacity, ok := common.Float32Value(control.PowerCapacityWatts); ok && capacity > 0 && watts > capacity {
+		return fmt.Errorf("power limit %g W exceeds the capacity of %g W", watts, capacity)
+	}
+	if correctionMs < 0 {
+		return fmt.Errorf("correction time must not be negative, got %d ms", correctionMs)
+	}
+
+	limit := struct {
+		LimitInWatts   float32
+		LimitException PowerLimitException `json:",omitempty"`
+		CorrectionInMs int64               `json:",omitempty"`
+	}{
+		LimitInWatts:   watts,
+		LimitException: exception,
+		CorrectionInMs: correctionMs,
+	}
+
+	refreshed, err := power.patchPowerLimit(index, limit)
+	if err != nil || refreshed {
+		return err
+	}
+
+	control.PowerLimit.LimitInWatts = common.Float32(watts)
+	if exception != "" {
+		control.PowerLimit.LimitException = exception
+	}
+	if correctionMs > 0 {
+		control.PowerLimit.CorrectionInMs = correctionMs
+	}
+
+	return nil
+}
+
+// ClearPowerLimit removes the power cap of the PowerControl with the given
+// MemberId. The request only succeeds if the resource has not changed since
+// it was read, so it fails if the service does not report an ETag for the
+// resource, unless AllowUnconditionalPatch is set.
+func (power *Power) ClearPowerLimit(memberID string) error {
+	index, err := power.powerControlIndex(memberID)
+	if err != nil {
+		return err
+	}
+
+	limit := struct {
+		LimitInWatts *float32
+	}{}
+
+	refreshed, err := power.patchPowerLimit(index, limit)
+	if err != nil || refreshed {
+		return err
+	}
+
+	power.PowerControl[index].PowerLimit.LimitInWatts = nil
+	return nil
+}
+
+// powerControlIndex finds the PowerControl with the given MemberId.
+func (power *Power) powerControlIndex(memberID string) (int, error) {
+	for i := range power.PowerControl {
+		if power.PowerControl[i].MemberID == memberID {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("power control %s not found", memberID)
+}
+
+// patchPowerLimit sends the PowerLimit of one PowerControl, leaving the
+// other array elements unchanged with empty objects. The PATCH is only sent
+// with the ETag of the resource, so it fails rather than overwrite changes
+// made since the resource was read, unless AllowUnconditionalPatch is set
+// and the resource has no ETag. If the service does not return the new
+// ETag, the resource is read again for it, and true is returned as it then
+// holds the state of the service.
+func (power *Power) patchPowerLimit(index int, limit interface{}) (bool, error) {
+	if power.ODataEtag == "" && !power.AllowUnconditionalPatch {
+		return false, fmt.Errorf("power resource %s has no ETag, so its power limit cannot be changed safely", power.ODataID)
+	}
+
+	controls := make([]interface{}, len(power.PowerControl))
+	for i := range controls {
+		controls[i] = struct{}{}
+	}
+	controls[index] = struct {
+		PowerLimit interface{}
+	}{
+		PowerLimit: limit,
+	}
+
+	t := struct {
+		PowerControl []interface{}
+	}{
+		PowerControl: controls,
+	}
+
+	var resp *http.Response
+	var err error
+	if power.ODataEtag != "" {
+		resp, err = power.PatchIfMatch(power.ODataID, t, power.ODataEtag)
+	} else {
+		resp, err = power.Patch(power.ODataID, t)
+	}
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+
+	// The ETag changes with the update, so the old one cannot be used again
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		power.ODataEtag = etag
+		return false, nil
+	}
+
+	refreshed, err := GetPower(power.Client, power.ODataID)
+	if err != nil {
+		return false, err
+	}
+	refreshed.AllowUnconditionalPatch = power.AllowUnconditionalPatch
+	*power = *refreshed
+
+	return true, nil
+}
+
 // PowerControl is
 type PowerControl struct {
 	common.Entity
@@ -201,17 +355,17 @@ type PowerControl struct {
 	PhysicalContext common.PhysicalContext
 	// PowerAllocatedWatts shall represent the total power currently allocated
 	// to chassis resources.
-	PowerAllocatedWatts float32
+	PowerAllocatedWatts *float32
 	// PowerAvailableWatts shall represent the amount of power capacity (in
 	// Watts) not already allocated and shall equal PowerCapacityWatts -
 	// PowerAllocatedWatts.
-	PowerAvailableWatts float32
+	PowerAvailableWatts *float32
 	// PowerCapacityWatts shall represent the total power capacity that is
 	// available for allocation to the chassis resources.
-	PowerCapacityWatts float32
+	PowerCapacityWatts *float32
 	// PowerConsumedWatts shall represent the actual power being consumed (in
 	// Watts) by the chassis.
-	PowerConsumedWatts float32
+	PowerConsumedWatts *float32
 	// PowerLimit shall contain power limit status and configuration information
 	// for this chassis.
 	PowerLimit PowerLimit
@@ -221,12 +375,18 @@ type PowerControl struct {
 	// PowerRequestedWatts shall represent the
 	// amount of power (in Watts) that the chassis resource is currently
 	// requesting be budgeted to it for future use.
-	PowerRequestedWatts float32
+	PowerRequestedWatts *float32
 	// Status shall contain any status or health properties
 	// of the resource.
 	Status common.Status
 }
 
+// ConsumedWatts gets the power consumed by the chassis, in Watts, and
+// whether the service reported it.
+func (powercontrol *PowerControl) ConsumedWatts() (float32, bool) {
+	return common.Float32Value(powercontrol.PowerConsumedWatts)
+}
+
 // PowerLimit shall contain power limit status and
 // configuration information for this chassis.
 type PowerLimit struct {
@@ -242,7 +402,7 @@ type PowerLimit struct {
 	// LimitInWatts shall represent the power
 	// cap limit in watts for the resource. If set to null, power capping
 	// shall be disabled.
-	LimitInWatts float32
+	LimitInWatts *float32
 }
 
 // PowerMetric shall contain power metrics for power
@@ -252,7 +412,7 @@ type PowerMetric struct {
 	// AverageConsumedWatts shall represent the
 	// average power level that occurred averaged over the last IntervalInMin
 	// minutes.
-	AverageConsumedWatts float32
+	AverageConsumedWatts *float32
 	// IntervalInMin shall represent the time
 	// interval (or window), in minutes, in which the PowerMetrics properties
 	// are measured over.
@@ -260,11 +420,11 @@ type PowerMetric struct {
 	// MaxConsumedWatts shall represent the
 	// maximum power level in watts that occurred within the last
 	// IntervalInMin minutes.
-	MaxConsumedWatts float32
+	MaxConsumedWatts *float32
 	// MinConsumedWatts shall represent the
 	// minimum power level in watts that occurred within the last
 	// IntervalInMin minutes.
-	MinConsumedWatts float32
+	MinConsumedWatts *float32
 }
 
 // PowerSupply is Details of a power supplies associated with this system
@@ -296,11 +456,11 @@ type PowerSupply struct {
 	InputRanges []InputRange
 	// LastPowerOutputWatts shall contain the average power
 	// output, measured in Watts, of the associated power supply.
-	LastPowerOutputWatts float32
+	LastPowerOutputWatts *float32
 	// LineInputVoltage shall contain the value in Volts of
 	// the line input voltage (measured or configured for) that the power
 	// supply has been configured to operate with or is currently receiving.
-	LineInputVoltage float32
+	LineInputVoltage *float32
 	// LineInputVoltageType shall contain the type of input
 	// line voltage supported by the associated power supply.
 	LineInputVoltageType LineInputVoltageType
@@ -325,13 +485,13 @@ type PowerSupply struct {
 	// PowerCapacityWatts shall contiain the maximum amount
 	// of power, in Watts, that the associated power supply is rated to
 	// deliver.
-	PowerCapacityWatts float32
+	PowerCapacityWatts *float32
 	// PowerInputWatts shall contain the value of the
 	// measured input power, in Watts, of the associated power supply.
-	PowerInputWatts float32
+	PowerInputWatts *float32
 	// PowerOutputWatts shall contain the value of the
 	// measured output power, in Watts, of the associated power supply.
-	PowerOutputWatts float32
+	PowerOutputWatts *float32
 	// PowerSupplyType shall contain the input power type
 	// (AC or DC) of the associated power supply.
 	PowerSupplyType PowerSupplyType
@@ -380,33 +540,33 @@ type Voltage struct {
 	// LowerThresholdCritical shall indicate
 	// the present reading is below the normal range but is not yet fatal.
 	// Units shall use the same units as the related ReadingVolts propoerty.
-	LowerThresholdCritical float32
+	LowerThresholdCritical *float32
 	// LowerThresholdFatal shall indicate the
 	// present reading is below the normal range and is fatal. Units shall
 	// use the same units as the related ReadingVolts propoerty.
-	LowerThresholdFatal float32
+	LowerThresholdFatal *float32
 	// LowerThresholdNonCritical shall indicate
 	// the present reading is below the normal range but is not critical.
 	// Units shall use the same units as the related ReadingVolts propoerty.
-	LowerThresholdNonCritical float32
+	LowerThresholdNonCritical *float32
 	// MaxReadingRange shall indicate the
 	// highest possible value for ReadingVolts. Units shall use the same
 	// units as the related ReadingVolts propoerty.
-	MaxReadingRange float32
+	MaxReadingRange *float32
 	// MemberID shall uniquely identify the member within the collection. For
 	// services supporting Redfish v1.6 or higher, this value shall be the
 	// zero-based array index.
 	MemberID string `json:"MemberId"`
 	// MinReadingRange shall indicate the lowest possible value for ReadingVolts.
 	// Units shall use the same units as the related ReadingVolts property.
-	MinReadingRange float32
+	MinReadingRange *float32
 	// PhysicalContext shall be a description
 	// of the affected device or region within the chassis to which this
 	// voltage measurement applies.
 	PhysicalContext string
 	// ReadingVolts shall be the present
 	// reading of the voltage sensor's reading.
-	ReadingVolts float32
+	ReadingVolts *float32
 	// SensorNumber shall be a numerical
 	// identifier for this voltage sensor that is unique within this
 	// resource.
@@ -417,13 +577,31 @@ type Voltage struct {
 	// UpperThresholdCritical shall indicate
 	// the present reading is above the normal range but is not yet fatal.
 	// Units shall use the same units as the related ReadingVolts propoerty.
-	UpperThresholdCritical float32
+	UpperThresholdCritical *float32
 	// UpperThresholdFatal shall indicate the
 	// present reading is above the normal range and is fatal. Units shall
 	// use the same units as the related ReadingVolts propoerty.
-	UpperThresholdFatal float32
+	UpperThresholdFatal *float32
 	// UpperThresholdNonCritical shall indicate
 	// the present reading is above the normal range but is not critical.
 	// Units shall use the same units as the related ReadingVolts propoerty.
-	UpperThresholdNonCritical float32
+	UpperThresholdNonCritical *float32
+}
+
+// Volts gets the voltage in Volts, and whether the service reported it.
+func (voltage *Voltage) Volts() (float32, bool) {
+	return common.Float32Value(voltage.ReadingVolts)
+}
+
+// thresholds gets the thresholds of the voltage sensor in the form used by the
+// ThresholdEvaluator.
+func (voltage *Voltage) thresholds() SensorThresholds {
+	return SensorThresholds{
+		LowerNonCritical: voltage.LowerThresholdNonCritical,
+		LowerCritical:    voltage.LowerThresholdCritical,
+		LowerFatal:       voltage.LowerThresholdFatal,
+		UpperNonCritical: voltage.UpperThresholdNonCritical,
+		UpperCritical:    voltage.UpperThresholdCritical,
+		UpperFatal:       voltage.UpperThresholdFatal,
+	}
 }
@@ -6,6 +6,7 @@ package redfish
 
 import (
 	"encoding/json"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -161,7 +162,188 @@ func TestPower(t *testing.T) {
 			result.PowerSupplies[0].IndicatorLED)
 	}
 
-	if result.Voltages[0].MaxReadingRange != 10 {
-		t.Errorf("Invalid MaxReadingRange: %f", result.Voltages[0].MaxReadingRange)
+	if common.Float32Or(result.Voltages[0].MaxReadingRange, 0) != 10 {
+		t.Errorf("Invalid MaxReadingRange: %v", result.Voltages[0].MaxReadingRange)
+	}
+
+	if volts, ok := result.Voltages[0].Volts(); !ok || volts != 12 {
+		t.Errorf("Invalid voltage reading: %f", volts)
+	}
+
+	if watts, ok := result.PowerControl[0].ConsumedWatts(); !ok || watts != 100 {
+		t.Errorf("Invalid consumed power: %f", watts)
+	}
+
+	if reading, ok := common.Float32Value(result.Voltages[0].LowerThresholdFatal); !ok || reading != 0 {
+		t.Errorf("A zero threshold should be reported: %f", reading)
+	}
+
+	if result.Voltages[0].UpperThresholdFatal != nil {
+		t.Errorf("An absent threshold should not be reported: %f", *result.Voltages[0].UpperThresholdFatal)
+	}
+}
+
+var powerLimitBody = `{
+		"@odata.id": "/redfish/v1/Chassis/1/Power",
+		"@odata.etag": "W/\"1\"",
+		"Id": "Power",
+		"PowerControl": [{
+			"MemberId": "0",
+			"PowerCapacityWatts": 800
+		}, {
+			"MemberId": "1",
+			"PowerCapacityWatts": 400,
+			"PowerLimit": {
+				"LimitInWatts": 350,
+				"LimitException": "LogEventOnly",
+				"CorrectionInMs": 1000
+			}
+		}]
+	}`
+
+// TestPowerSetPowerLimit tests setting a power limit.
+func TestPowerSetPowerLimit(t *testing.T) {
+	var result Power
+	err := json.NewDecoder(strings.NewReader(powerLimitBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	testClient := &testClient{
+		headers: map[string]http.Header{
+			"PATCH /redfish/v1/Chassis/1/Power": {"Etag": {`W/"2"`}},
+		},
+	}
+	result.SetClient(testClient)
+
+	err = result.SetPowerLimit("1", 500, "", 0)
+	if err == nil {
+		t.Error("Limit above the capacity should fail")
+	}
+
+	err = result.SetPowerLimit("2", 300, "", 0)
+	if err == nil {
+		t.Error("Limit for an unknown member should fail")
+	}
+
+	if len(testClient.calls) != 0 {
+		t.Errorf("Invalid limits should not be sent: %v", testClient.calls)
+	}
+
+	err = result.SetPowerLimit("1", 300, HardPowerOffPowerLimitException, 0)
+	if err != nil {
+		t.Errorf("Error setting power limit: %s", err)
+	}
+
+	call := testClient.calls[0]
+	if call.Payload != `{"PowerControl":[{},{"PowerLimit":{"LimitInWatts":300,"LimitException":"HardPowerOff"}}]}` {
+		t.Errorf("Unexpected power limit payload: %s", call.Payload)
+	}
+
+	if call.IfMatch != `W/"1"` {
+		t.Errorf("Unexpected If-Match: %s", call.IfMatch)
+	}
+
+	limit := result.PowerControl[1].PowerLimit
+	if *limit.LimitInWatts != 300 || limit.LimitException != HardPowerOffPowerLimitException || limit.CorrectionInMs != 1000 {
+		t.Errorf("Unexpected power limit: %v", limit)
+	}
+
+	err = result.ClearPowerLimit("1")
+	if err != nil {
+		t.Errorf("Error clearing power limit: %s", err)
+	}
+
+	call = testClient.calls[1]
+	if call.Payload != `{"PowerControl":[{},{"PowerLimit":{"LimitInWatts":null}}]}` {
+		t.Errorf("Unexpected clear payload: %s", call.Payload)
+	}
+
+	if call.IfMatch != `W/"2"` {
+		t.Errorf("Clear should use the updated ETag, got: %s", call.IfMatch)
+	}
+
+	if result.PowerControl[1].PowerLimit.LimitInWatts != nil {
+		t.Errorf("Power limit should be cleared")
+	}
+}
+
+// TestPowerSetPowerLimitRefreshETag tests that the resource is read again
+// when the service does not return the new ETag.
+func TestPowerSetPowerLimitRefreshETag(t *testing.T) {
+	var result Power
+	err := json.NewDecoder(strings.NewReader(powerLimitBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	testClient := &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/Chassis/1/Power": strings.Replace(strings.Replace(powerLimitBody,
+				`W/\"1\"`, `W/\"3\"`, 1), `"LimitInWatts": 350`, `"LimitInWatts": 300`, 1),
+		},
+	}
+	result.SetClient(testClient)
+
+	err = result.SetPowerLimit("1", 300, "", 0)
+	if err != nil {
+		t.Errorf("Error setting power limit: %s", err)
+	}
+
+	if len(testClient.calls) != 2 || testClient.calls[1].Method != http.MethodGet {
+		t.Fatalf("Expected the resource to be read again: %v", testClient.calls)
+	}
+
+	if result.ODataEtag != `W/"3"` || *result.PowerControl[1].PowerLimit.LimitInWatts != 300 {
+		t.Errorf("Unexpected refreshed resource: %s %v", result.ODataEtag, result.PowerControl[1].PowerLimit)
+	}
+
+	err = result.ClearPowerLimit("1")
+	if err != nil {
+		t.Errorf("Error clearing power limit: %s", err)
+	}
+
+	if testClient.calls[2].IfMatch != `W/"3"` {
+		t.Errorf("Clear should use the refreshed ETag, got: %s", testClient.calls[2].IfMatch)
+	}
+}
+
+// TestPowerSetPowerLimitNoETag tests that a limit is not sent without an
+// ETag.
+func TestPowerSetPowerLimitNoETag(t *testing.T) {
+	var result Power
+	err := json.NewDecoder(strings.NewReader(strings.Replace(powerLimitBody,
+		`"@odata.etag": "W/\"1\"",`, "", 1))).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	testClient := &testClient{}
+	result.SetClient(testClient)
+
+	err = result.SetPowerLimit("1", 300, "", 0)
+	if err == nil {
+		t.Error("Setting a limit without an ETag should fail")
+	}
+
+	if len(testClient.calls) != 0 {
+		t.Errorf("Limit should not be sent without an ETag: %v", testClient.calls)
+	}
+
+	testClient.responses = map[string]string{
+		"GET /redfish/v1/Chassis/1/Power": strings.Replace(powerLimitBody, `"@odata.etag": "W/\"1\"",`, "", 1),
+	}
+	result.AllowUnconditionalPatch = true
+	err = result.SetPowerLimit("1", 300, "", 0)
+	if err != nil {
+		t.Errorf("Error setting power limit without an ETag: %s", err)
+	}
+
+	if len(testClient.calls) == 0 || testClient.calls[0].Method != http.MethodPatch || testClient.calls[0].IfMatch != "" {
+		t.Errorf("Expected an unconditional PATCH: %v", testClient.calls)
+	}
+
+	if !result.AllowUnconditionalPatch {
+		t.Error("Reading the resource again should keep AllowUnconditionalPatch")
 	}
 }
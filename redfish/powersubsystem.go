@@ -0,0 +1,101 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// PowerSubsystemAllocation shall contain the power allocation of a
+// subsystem.
+type PowerSubsystemAllocation struct {
+	// AllocatedWatts shall contain the total amount of power allocated to
+	// the subsystem.
+	AllocatedWatts *float32
+	// RequestedWatts shall contain the amount of power requested by the
+	// subsystem.
+	RequestedWatts *float32
+}
+
+// PowerSubsystem shall represent the power subsystem of a chassis. It
+// replaces the deprecated Power resource.
+type PowerSubsystem struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Allocation shall contain the power allocation of this subsystem.
+	Allocation PowerSubsystemAllocation
+	// CapacityWatts shall contain the total power capacity available for
+	// allocation to this subsystem.
+	CapacityWatts *float32
+	// Description provides a description of this resource.
+	Description string
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// batteries is the link to the collection of batteries.
+	batteries string
+	// powerSupplies is the link to the collection of power supplies.
+	powerSupplies string
+}
+
+// UnmarshalJSON unmarshals a PowerSubsystem object from the raw JSON.
+func (powersubsystem *PowerSubsystem) UnmarshalJSON(b []byte) error {
+	type temp PowerSubsystem
+	var t struct {
+		temp
+		Batteries     common.Link
+		PowerSupplies common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*powersubsystem = PowerSubsystem(t.temp)
+
+	// Extract the links to other entities for later
+	powersubsystem.batteries = string(t.Batteries)
+	powersubsystem.powerSupplies = string(t.PowerSupplies)
+
+	return nil
+}
+
+// GetPowerSubsystem will get a PowerSubsystem instance from the service.
+func GetPowerSubsystem(c common.Client, uri string) (*PowerSubsystem, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var powersubsystem PowerSubsystem
+	err = json.NewDecoder(resp.Body).Decode(&powersubsystem)
+	if err != nil {
+		return nil, err
+	}
+
+	powersubsystem.SetClient(c)
+	return &powersubsystem, nil
+}
+
+// PowerSupplies gets the power supplies of this subsystem.
+func (powersubsystem *PowerSubsystem) PowerSupplies() ([]*PowerSupplyUnit, error) {
+	return ListReferencedPowerSupplyUnits(powersubsystem.Client, powersubsystem.powerSupplies)
+}
+
+// Batteries gets the batteries of this subsystem.
+func (powersubsystem *PowerSubsystem) Batteries() ([]*Battery, error) {
+	return ListReferencedBatteries(powersubsystem.Client, powersubsystem.batteries)
+}
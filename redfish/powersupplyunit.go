@@ -0,0 +1,239 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// LineStatus is the status of the input line of a power supply.
+type LineStatus string
+
+const (
+	// NormalLineStatus Line input is within normal operating range.
+	NormalLineStatus LineStatus = "Normal"
+	// LossOfInputLineStatus No power detected at line input.
+	LossOfInputLineStatus LineStatus = "LossOfInput"
+	// OutOfRangeLineStatus Line input voltage or current is outside of
+	// normal operating range.
+	OutOfRangeLineStatus LineStatus = "OutOfRange"
+)
+
+// EfficiencyRating shall describe an efficiency rating of a power supply.
+type EfficiencyRating struct {
+	// EfficiencyPercent shall contain the rated efficiency of the power
+	// supply at the specified load.
+	EfficiencyPercent *float32
+	// LoadPercent shall contain the load, as a percentage of the capacity of
+	// the power supply, for the rating.
+	LoadPercent *float32
+}
+
+// PowerSupplyUnit shall represent a power supply of a PowerSubsystem. It
+// replaces the PowerSupply objects of the deprecated Power resource.
+type PowerSupplyUnit struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// EfficiencyRatings shall contain an array of efficiency ratings for
+	// this power supply.
+	EfficiencyRatings []EfficiencyRating
+	// FirmwareVersion shall contain the firmware version as defined by the
+	// manufacturer for this power supply.
+	FirmwareVersion string
+	// HotPluggable shall indicate whether the device can be inserted or
+	// removed while the underlying equipment otherwise remains in its
+	// current operational state.
+	HotPluggable bool
+	// LineInputStatus shall contain the status of the power line input for
+	// this power supply.
+	LineInputStatus LineStatus
+	// Location shall contain the location information of the power supply.
+	Location common.Location
+	// LocationIndicatorActive shall contain the state of the indicator used
+	// to physically identify or locate this resource.
+	LocationIndicatorActive bool
+	// Manufacturer shall contain the name of the organization responsible
+	// for producing the power supply.
+	Manufacturer string
+	// Model shall contain the model information as defined by the
+	// manufacturer for this power supply.
+	Model string
+	// PartNumber shall contain the part number as defined by the
+	// manufacturer for this power supply.
+	PartNumber string
+	// PowerCapacityWatts shall contain the maximum amount of power, in
+	// Watts, that this power supply is rated to deliver.
+	PowerCapacityWatts *float32
+	// PowerSupplyType shall contain the input power type (AC or DC) of this
+	// power supply.
+	PowerSupplyType PowerSupplyType
+	// Replaceable shall indicate whether this component can be independently
+	// replaced.
+	Replaceable bool
+	// SerialNumber shall contain the serial number as defined by the
+	// manufacturer for this power supply.
+	SerialNumber string
+	// SparePartNumber shall contain the spare or replacement part number as
+	// defined by the manufacturer for this power supply.
+	SparePartNumber string
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// Version shall contain the hardware version of this power supply.
+	Version string
+	// metrics is the link to the metrics of this power supply.
+	metrics string
+}
+
+// UnmarshalJSON unmarshals a PowerSupplyUnit object from the raw JSON.
+func (powersupplyunit *PowerSupplyUnit) UnmarshalJSON(b []byte) error {
+	type temp PowerSupplyUnit
+	var t struct {
+		temp
+		Metrics common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*powersupplyunit = PowerSupplyUnit(t.temp)
+
+	// Extract the links to other entities for later
+	powersupplyunit.metrics = string(t.Metrics)
+
+	return nil
+}
+
+// GetPowerSupplyUnit will get a PowerSupplyUnit instance from the service.
+func GetPowerSupplyUnit(c common.Client, uri string) (*PowerSupplyUnit, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var powersupplyunit PowerSupplyUnit
+	err = json.NewDecoder(resp.Body).Decode(&powersupplyunit)
+	if err != nil {
+		return nil, err
+	}
+
+	powersupplyunit.SetClient(c)
+	return &powersupplyunit, nil
+}
+
+// ListReferencedPowerSupplyUnits gets the collection of PowerSupplyUnit from
+// a provided reference.
+func ListReferencedPowerSupplyUnits(c common.Client, link string) ([]*PowerSupplyUnit, error) {
+	var result []*PowerSupplyUnit
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, powersupplyunitLink := range links.ItemLinks {
+		powersupplyunit, err := GetPowerSupplyUnit(c, powersupplyunitLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, powersupplyunit)
+	}
+
+	return result, nil
+}
+
+// Metrics gets the metrics of this power supply.
+func (powersupplyunit *PowerSupplyUnit) Metrics() (*PowerSupplyMetrics, error) {
+	if powersupplyunit.metrics == "" {
+		return nil, nil
+	}
+	return GetPowerSupplyMetrics(powersupplyunit.Client, powersupplyunit.metrics)
+}
+
+// PowerSupplyMetrics shall represent the metrics of a PowerSupplyUnit.
+type PowerSupplyMetrics struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// EnergykWh shall contain the total energy, in kilowatt-hours, consumed
+	// by this power supply.
+	EnergykWh SensorExcerpt
+	// FanSpeedPercent shall contain the fan speed, in percent, of this power
+	// supply.
+	FanSpeedPercent SensorFanExcerpt
+	// FrequencyHz shall contain the frequency, in Hertz, of the input line.
+	FrequencyHz SensorExcerpt
+	// InputCurrentAmps shall contain the input current, in Amperes, of this
+	// power supply.
+	InputCurrentAmps SensorExcerpt
+	// InputPowerWatts shall contain the input power, in Watts, of this power
+	// supply.
+	InputPowerWatts SensorExcerpt
+	// InputVoltage shall contain the input voltage, in Volts, of this power
+	// supply.
+	InputVoltage SensorExcerpt
+	// OutputPowerWatts shall contain the total output power, in Watts, of
+	// this power supply.
+	OutputPowerWatts SensorExcerpt
+	// RailCurrentAmps shall contain the output currents, in Amperes, of the
+	// rails of this power supply.
+	RailCurrentAmps []SensorExcerpt
+	// RailPowerWatts shall contain the output power, in Watts, of the rails
+	// of this power supply.
+	RailPowerWatts []SensorExcerpt
+	// RailVoltage shall contain the output voltages, in Volts, of the rails
+	// of this power supply.
+	RailVoltage []SensorExcerpt
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// TemperatureCelsius shall contain the temperature, in degrees Celsius,
+	// of this power supply.
+	TemperatureCelsius SensorExcerpt
+}
+
+// GetPowerSupplyMetrics will get a PowerSupplyMetrics instance from the
+// service.
+func GetPowerSupplyMetrics(c common.Client, uri string) (*PowerSupplyMetrics, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var powersupplymetrics PowerSupplyMetrics
+	err = json.NewDecoder(resp.Body).Decode(&powersupplymetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	powersupplymetrics.SetClient(c)
+	return &powersupplymetrics, nil
+}
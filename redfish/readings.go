@@ -0,0 +1,368 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// ReadingsModel is the resource model readings were taken from.
+type ReadingsModel string
+
+const (
+	// NoReadingsModel The chassis does not report the readings.
+	NoReadingsModel ReadingsModel = ""
+	// LegacyReadingsModel The readings were taken from the deprecated Power
+	// and Thermal resources.
+	LegacyReadingsModel ReadingsModel = "Legacy"
+	// SubsystemReadingsModel The readings were taken from the
+	// PowerSubsystem, ThermalSubsystem and Sensor resources.
+	SubsystemReadingsModel ReadingsModel = "Subsystem"
+)
+
+// SensorReading is a reading normalized from either resource model.
+type SensorReading struct {
+	// Sensor is the location of the sensor.
+	Sensor string
+	// Name is the name of the sensor.
+	Name string
+	// PhysicalContext is the area or device the reading applies to.
+	PhysicalContext common.PhysicalContext
+	// Reading is the value of the sensor, nil if it was not reported.
+	Reading *float32
+	// ReadingUnits are the UCUM units of the reading and thresholds, such as
+	// "Cel", "V", "RPM" or "%".
+	ReadingUnits string
+	// Thresholds are the thresholds of the sensor.
+	Thresholds SensorThresholds
+	// Status is the status of the sensor.
+	Status common.Status
+}
+
+// PowerSupplyReading is the readings of a power supply normalized from
+// either resource model.
+type PowerSupplyReading struct {
+	// PowerSupply is the location of the power supply.
+	PowerSupply string
+	// Name is the name of the power supply.
+	Name string
+	// PowerCapacityWatts is the power the supply is rated to deliver.
+	PowerCapacityWatts *float32
+	// InputWatts is the power drawn by the supply.
+	InputWatts *float32
+	// OutputWatts is the power delivered by the supply.
+	OutputWatts *float32
+	// InputVoltage is the voltage of the input line.
+	InputVoltage *float32
+	// Status is the status of the power supply.
+	Status common.Status
+}
+
+// ChassisReadings is the power and thermal readings of a chassis,
+// normalized from whichever resource model the chassis implements.
+type ChassisReadings struct {
+	// PowerModel is the model the voltages and power supplies were taken
+	// from.
+	PowerModel ReadingsModel
+	// ThermalModel is the model the temperatures and fans were taken from.
+	ThermalModel ReadingsModel
+	// Temperatures are the temperatures, in degrees Celsius.
+	Temperatures []SensorReading
+	// Fans are the fan speeds, in RPM or percent of their maximum speed.
+	Fans []SensorReading
+	// Voltages are the voltages, in Volts.
+	Voltages []SensorReading
+	// PowerSupplies are the power supplies.
+	PowerSupplies []PowerSupplyReading
+}
+
+// Readings gets the power and thermal readings of the chassis. The
+// PowerSubsystem, ThermalSubsystem and Sensors of the chassis are used if
+// it has them, otherwise the deprecated Power and Thermal resources are
+// used. Absent sensors and devices are left out.
+func (c *Chassis) Readings() (*ChassisReadings, error) {
+	readings := &ChassisReadings{}
+
+	var sensors []*Sensor
+	var err error
+	if c.sensors != "" && (c.powerSubsystem != "" || c.thermalSubsystem != "") {
+		sensors, err = c.Sensors()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case c.thermalSubsystem != "":
+		readings.ThermalModel = SubsystemReadingsModel
+		err = c.subsystemThermalReadings(readings, sensors)
+	case c.thermal != "":
+		readings.ThermalModel = LegacyReadingsModel
+		err = c.legacyThermalReadings(readings)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case c.powerSubsystem != "":
+		readings.PowerModel = SubsystemReadingsModel
+		err = c.subsystemPowerReadings(readings, sensors)
+	case c.power != "":
+		readings.PowerModel = LegacyReadingsModel
+		err = c.legacyPowerReadings(readings)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return readings, nil
+}
+
+// subsystemThermalReadings reads the temperatures from the sensors, or the
+// thermal metrics if the chassis has no sensors, and the fans from the
+// ThermalSubsystem.
+func (c *Chassis) subsystemThermalReadings(readings *ChassisReadings, sensors []*Sensor) error {
+	subsystem, err := c.ThermalSubsystem()
+	if err != nil {
+		return err
+	}
+
+	if c.sensors != "" {
+		readings.Temperatures = sensorReadings(sensors, TemperatureReadingType)
+	} else {
+		metrics, err := subsystem.ThermalMetrics()
+		if err != nil {
+			return err
+		}
+		if metrics != nil {
+			for _, excerpt := range metrics.TemperatureReadingsCelsius {
+				readings.Temperatures = append(readings.Temperatures, SensorReading{
+					Sensor:          excerpt.DataSourceURI,
+					Name:            excerpt.DeviceName,
+					PhysicalContext: excerpt.PhysicalContext,
+					Reading:         excerpt.Reading,
+					ReadingUnits:    "Cel",
+				})
+			}
+		}
+	}
+
+	fans, err := subsystem.Fans()
+	if err != nil {
+		return err
+	}
+
+	for _, fan := range fans {
+		if fan.Status.State == common.AbsentState {
+			continue
+		}
+
+		reading := SensorReading{
+			Sensor:          fan.ODataID,
+			Name:            fan.Name,
+			PhysicalContext: fan.PhysicalContext,
+			Reading:         fan.SpeedPercent.Reading,
+			ReadingUnits:    "%",
+			Status:          fan.Status,
+		}
+		if fan.SpeedPercent.SpeedRPM != nil {
+			reading.Reading = fan.SpeedPercent.SpeedRPM
+			reading.ReadingUnits = "RPM"
+		}
+		for _, sensor := range sensors {
+			if sensor.ODataID == fan.SpeedPercent.DataSourceURI && sensor.ReadingUnits == reading.ReadingUnits {
+				reading.Thresholds = sensor.Thresholds.SensorThresholds()
+			}
+		}
+		readings.Fans = append(readings.Fans, reading)
+	}
+
+	return nil
+}
+
+// subsystemPowerReadings reads the voltages from the sensors, or the Power
+// resource if the chassis has no sensors, and the power supplies from the
+// PowerSubsystem.
+func (c *Chassis) subsystemPowerReadings(readings *ChassisReadings, sensors []*Sensor) error {
+	if c.sensors != "" {
+		readings.Voltages = sensorReadings(sensors, VoltageReadingType)
+	} else if c.power != "" {
+		power, err := c.Power()
+		if err != nil {
+			return err
+		}
+		readings.Voltages = legacyVoltageReadings(power)
+	}
+
+	subsystem, err := c.PowerSubsystem()
+	if err != nil {
+		return err
+	}
+
+	supplies, err := subsystem.PowerSupplies()
+	if err != nil {
+		return err
+	}
+
+	for _, supply := range supplies {
+		if supply.Status.State == common.AbsentState {
+			continue
+		}
+
+		reading := PowerSupplyReading{
+			PowerSupply:        supply.ODataID,
+			Name:               supply.Name,
+			PowerCapacityWatts: supply.PowerCapacityWatts,
+			Status:             supply.Status,
+		}
+
+		metrics, err := supply.Metrics()
+		if err != nil {
+			return err
+		}
+		if metrics != nil {
+			reading.InputWatts = metrics.InputPowerWatts.Reading
+			reading.OutputWatts = metrics.OutputPowerWatts.Reading
+			reading.InputVoltage = metrics.InputVoltage.Reading
+		}
+
+		readings.PowerSupplies = append(readings.PowerSupplies, reading)
+	}
+
+	return nil
+}
+
+// legacyThermalReadings reads the temperatures and fans from the Thermal
+// resource.
+func (c *Chassis) legacyThermalReadings(readings *ChassisReadings) error {
+	thermal, err := c.Thermal()
+	if err != nil {
+		return err
+	}
+
+	for i := range thermal.Temperatures {
+		temperature := &thermal.Temperatures[i]
+		if temperature.Status.State == common.AbsentState {
+			continue
+		}
+
+		readings.Temperatures = append(readings.Temperatures, SensorReading{
+			Sensor:          temperature.ODataID,
+			Name:            temperature.Name,
+			PhysicalContext: common.PhysicalContext(temperature.PhysicalContext),
+			Reading:         temperature.ReadingCelsius,
+			ReadingUnits:    "Cel",
+			Thresholds:      temperature.thresholds(),
+			Status:          temperature.Status,
+		})
+	}
+
+	for i := range thermal.Fans {
+		fan := &thermal.Fans[i]
+		if fan.Status.State == common.AbsentState {
+			continue
+		}
+
+		units := "RPM"
+		if fan.ReadingUnits == PercentReadingUnits {
+			units = "%"
+		}
+
+		readings.Fans = append(readings.Fans, SensorReading{
+			Sensor:          fan.ODataID,
+			Name:            fan.Name,
+			PhysicalContext: common.PhysicalContext(fan.PhysicalContext),
+			Reading:         fan.Reading,
+			ReadingUnits:    units,
+			Thresholds:      fan.thresholds(),
+			Status:          fan.Status,
+		})
+	}
+
+	return nil
+}
+
+// legacyPowerReadings reads the voltages and power supplies from the Power
+// resource. Power supplies do not have names there, so they are named by
+// their MemberId.
+func (c *Chassis) legacyPowerReadings(readings *ChassisReadings) error {
+	power, err := c.Power()
+	if err != nil {
+		return err
+	}
+
+	readings.Voltages = legacyVoltageReadings(power)
+
+	for i := range power.PowerSupplies {
+		supply := &power.PowerSupplies[i]
+		if supply.Status.State == common.AbsentState {
+			continue
+		}
+
+		output := supply.PowerOutputWatts
+		if output == nil {
+			output = supply.LastPowerOutputWatts
+		}
+
+		readings.PowerSupplies = append(readings.PowerSupplies, PowerSupplyReading{
+			PowerSupply:        supply.ODataID,
+			Name:               supply.MemberID,
+			PowerCapacityWatts: supply.PowerCapacityWatts,
+			InputWatts:         supply.PowerInputWatts,
+			OutputWatts:        output,
+			InputVoltage:       supply.LineInputVoltage,
+			Status:             supply.Status,
+		})
+	}
+
+	return nil
+}
+
+// legacyVoltageReadings gets the voltages of the Power resource, which do
+// not have names, so they are named by their MemberId.
+func legacyVoltageReadings(power *Power) []SensorReading {
+	var voltages []SensorReading
+	for i := range power.Voltages {
+		voltage := &power.Voltages[i]
+		if voltage.Status.State == common.AbsentState {
+			continue
+		}
+
+		voltages = append(voltages, SensorReading{
+			Sensor:          voltage.ODataID,
+			Name:            voltage.MemberID,
+			PhysicalContext: common.PhysicalContext(voltage.PhysicalContext),
+			Reading:         voltage.ReadingVolts,
+			ReadingUnits:    "V",
+			Thresholds:      voltage.thresholds(),
+			Status:          voltage.Status,
+		})
+	}
+
+	return voltages
+}
+
+// sensorReadings gets the readings of the sensors of the given type.
+func sensorReadings(sensors []*Sensor, readingType ReadingType) []SensorReading {
+	var result []SensorReading
+	for _, sensor := range sensors {
+		if sensor.ReadingType != readingType || sensor.Status.State == common.AbsentState {
+			continue
+		}
+
+		result = append(result, SensorReading{
+			Sensor:          sensor.ODataID,
+			Name:            sensor.Name,
+			PhysicalContext: sensor.PhysicalContext,
+			Reading:         sensor.Reading,
+			ReadingUnits:    sensor.ReadingUnits,
+			Thresholds:      sensor.Thresholds.SensorThresholds(),
+			Status:          sensor.Status,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,311 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// subsystemChassisClient serves a chassis that implements the
+// PowerSubsystem, ThermalSubsystem and Sensor model.
+func subsystemChassisClient() *testClient {
+	return &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/Chassis/1/ThermalSubsystem": `{
+				"@odata.id": "/redfish/v1/Chassis/1/ThermalSubsystem",
+				"Fans": {"@odata.id": "/redfish/v1/Chassis/1/ThermalSubsystem/Fans"},
+				"ThermalMetrics": {"@odata.id": "/redfish/v1/Chassis/1/ThermalSubsystem/ThermalMetrics"}
+			}`,
+			"GET /redfish/v1/Chassis/1/ThermalSubsystem/Fans": `{
+				"Members": [
+					{"@odata.id": "/redfish/v1/Chassis/1/ThermalSubsystem/Fans/1"},
+					{"@odata.id": "/redfish/v1/Chassis/1/ThermalSubsystem/Fans/2"}
+				],
+				"Members@odata.count": 2
+			}`,
+			"GET /redfish/v1/Chassis/1/ThermalSubsystem/Fans/1": `{
+				"@odata.id": "/redfish/v1/Chassis/1/ThermalSubsystem/Fans/1",
+				"Name": "Fan 1",
+				"PhysicalContext": "SystemBoard",
+				"SpeedPercent": {
+					"DataSourceUri": "/redfish/v1/Chassis/1/Sensors/Fan1",
+					"Reading": 45,
+					"SpeedRPM": 6000
+				},
+				"Status": {"State": "Enabled", "Health": "OK"}
+			}`,
+			"GET /redfish/v1/Chassis/1/ThermalSubsystem/Fans/2": `{
+				"@odata.id": "/redfish/v1/Chassis/1/ThermalSubsystem/Fans/2",
+				"Name": "Fan 2",
+				"Status": {"State": "Absent"}
+			}`,
+			"GET /redfish/v1/Chassis/1/PowerSubsystem": `{
+				"@odata.id": "/redfish/v1/Chassis/1/PowerSubsystem",
+				"CapacityWatts": 1600,
+				"Allocation": {"AllocatedWatts": 1200, "RequestedWatts": null},
+				"PowerSupplies": {"@odata.id": "/redfish/v1/Chassis/1/PowerSubsystem/PowerSupplies"},
+				"Batteries": {"@odata.id": "/redfish/v1/Chassis/1/PowerSubsystem/Batteries"}
+			}`,
+			"GET /redfish/v1/Chassis/1/PowerSubsystem/PowerSupplies": `{
+				"Members": [{"@odata.id": "/redfish/v1/Chassis/1/PowerSubsystem/PowerSupplies/1"}],
+				"Members@odata.count": 1
+			}`,
+			"GET /redfish/v1/Chassis/1/PowerSubsystem/PowerSupplies/1": `{
+				"@odata.id": "/redfish/v1/Chassis/1/PowerSubsystem/PowerSupplies/1",
+				"Name": "PSU 1",
+				"PowerCapacityWatts": 800,
+				"LineInputStatus": "Normal",
+				"Metrics": {"@odata.id": "/redfish/v1/Chassis/1/PowerSubsystem/PowerSupplies/1/Metrics"},
+				"Status": {"State": "Enabled", "Health": "OK"}
+			}`,
+			"GET /redfish/v1/Chassis/1/PowerSubsystem/PowerSupplies/1/Metrics": `{
+				"InputPowerWatts": {"Reading": 410},
+				"OutputPowerWatts": {"Reading": 380},
+				"InputVoltage": {"Reading": 230.5}
+			}`,
+			"GET /redfish/v1/Chassis/1/PowerSubsystem/Batteries": `{
+				"Members": [{"@odata.id": "/redfish/v1/Chassis/1/PowerSubsystem/Batteries/1"}],
+				"Members@odata.count": 1
+			}`,
+			"GET /redfish/v1/Chassis/1/PowerSubsystem/Batteries/1": `{
+				"Name": "Battery 1",
+				"ChargeState": "Charging",
+				"CapacityRatedWattHours": 50,
+				"StateOfHealthPercent": {"Reading": 92}
+			}`,
+			"GET /redfish/v1/Chassis/1/Sensors": `{
+				"Members": [
+					{"@odata.id": "/redfish/v1/Chassis/1/Sensors/CPU1Temp"},
+					{"@odata.id": "/redfish/v1/Chassis/1/Sensors/Fan1"},
+					{"@odata.id": "/redfish/v1/Chassis/1/Sensors/VRM1"}
+				],
+				"Members@odata.count": 3
+			}`,
+			"GET /redfish/v1/Chassis/1/Sensors/CPU1Temp": `{
+				"@odata.id": "/redfish/v1/Chassis/1/Sensors/CPU1Temp",
+				"Name": "CPU 1 Temperature",
+				"ReadingType": "Temperature",
+				"ReadingUnits": "Cel",
+				"Reading": 62,
+				"PhysicalContext": "CPU",
+				"Thresholds": {
+					"UpperCaution": {"Reading": 80},
+					"UpperCritical": {"Reading": 90, "Activation": "Increasing", "HysteresisReading": 2}
+				}
+			}`,
+			"GET /redfish/v1/Chassis/1/Sensors/Fan1": `{
+				"@odata.id": "/redfish/v1/Chassis/1/Sensors/Fan1",
+				"Name": "Fan 1 Speed",
+				"ReadingType": "Rotational",
+				"ReadingUnits": "RPM",
+				"Reading": 6000,
+				"Thresholds": {"LowerCritical": {"Reading": 1000}}
+			}`,
+			"GET /redfish/v1/Chassis/1/Sensors/VRM1": `{
+				"@odata.id": "/redfish/v1/Chassis/1/Sensors/VRM1",
+				"Name": "VRM 1 Voltage",
+				"ReadingType": "Voltage",
+				"ReadingUnits": "V",
+				"Reading": 1.8,
+				"PhysicalContext": "VoltageRegulator"
+			}`,
+		},
+	}
+}
+
+// TestChassisReadingsSubsystem tests normalizing the readings of a chassis
+// with the subsystem model.
+func TestChassisReadingsSubsystem(t *testing.T) {
+	var chassis Chassis
+	err := json.Unmarshal([]byte(`{
+		"@odata.id": "/redfish/v1/Chassis/1",
+		"Power": {"@odata.id": "/redfish/v1/Chassis/1/Power"},
+		"PowerSubsystem": {"@odata.id": "/redfish/v1/Chassis/1/PowerSubsystem"},
+		"ThermalSubsystem": {"@odata.id": "/redfish/v1/Chassis/1/ThermalSubsystem"},
+		"Sensors": {"@odata.id": "/redfish/v1/Chassis/1/Sensors"}
+	}`), &chassis)
+	if err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+	chassis.SetClient(subsystemChassisClient())
+
+	readings, err := chassis.Readings()
+	if err != nil {
+		t.Fatalf("Error getting readings: %s", err)
+	}
+
+	if readings.PowerModel != SubsystemReadingsModel || readings.ThermalModel != SubsystemReadingsModel {
+		t.Errorf("Expected subsystem models, got %s and %s", readings.PowerModel, readings.ThermalModel)
+	}
+
+	if len(readings.Temperatures) != 1 {
+		t.Fatalf("Expected 1 temperature, got %d", len(readings.Temperatures))
+	}
+	temperature := readings.Temperatures[0]
+	if temperature.Name != "CPU 1 Temperature" || *temperature.Reading != 62 || temperature.PhysicalContext != "CPU" {
+		t.Errorf("Unexpected temperature: %+v", temperature)
+	}
+	if *temperature.Thresholds.UpperNonCritical != 80 || *temperature.Thresholds.UpperCritical != 90 ||
+		temperature.Thresholds.UpperFatal != nil {
+		t.Errorf("Unexpected temperature thresholds: %+v", temperature.Thresholds)
+	}
+
+	if len(readings.Fans) != 1 {
+		t.Fatalf("Expected 1 fan, got %d", len(readings.Fans))
+	}
+	fan := readings.Fans[0]
+	if fan.Name != "Fan 1" || *fan.Reading != 6000 || fan.ReadingUnits != "RPM" {
+		t.Errorf("Unexpected fan: %+v", fan)
+	}
+	if fan.Thresholds.LowerCritical == nil || *fan.Thresholds.LowerCritical != 1000 {
+		t.Errorf("Fan thresholds should come from its sensor: %+v", fan.Thresholds)
+	}
+
+	if len(readings.Voltages) != 1 || *readings.Voltages[0].Reading != 1.8 {
+		t.Errorf("Unexpected voltages: %+v", readings.Voltages)
+	}
+
+	if len(readings.PowerSupplies) != 1 {
+		t.Fatalf("Expected 1 power supply, got %d", len(readings.PowerSupplies))
+	}
+	supply := readings.PowerSupplies[0]
+	if supply.Name != "PSU 1" || *supply.PowerCapacityWatts != 800 || *supply.InputWatts != 410 ||
+		*supply.OutputWatts != 380 || *supply.InputVoltage != 230.5 {
+		t.Errorf("Unexpected power supply: %+v", supply)
+	}
+}
+
+// TestPowerSubsystemBatteries tests reading the batteries of a power
+// subsystem.
+func TestPowerSubsystemBatteries(t *testing.T) {
+	subsystem, err := GetPowerSubsystem(subsystemChassisClient(), "/redfish/v1/Chassis/1/PowerSubsystem")
+	if err != nil {
+		t.Fatalf("Error getting power subsystem: %s", err)
+	}
+
+	if *subsystem.CapacityWatts != 1600 || *subsystem.Allocation.AllocatedWatts != 1200 ||
+		subsystem.Allocation.RequestedWatts != nil {
+		t.Errorf("Unexpected power subsystem: %+v", subsystem)
+	}
+
+	batteries, err := subsystem.Batteries()
+	if err != nil {
+		t.Fatalf("Error getting batteries: %s", err)
+	}
+
+	if len(batteries) != 1 || batteries[0].ChargeState != ChargingChargeState ||
+		*batteries[0].StateOfHealthPercent.Reading != 92 {
+		t.Errorf("Unexpected batteries: %+v", batteries)
+	}
+}
+
+// TestChassisReadingsLegacy tests normalizing the readings of a chassis
+// with the deprecated Power and Thermal resources.
+func TestChassisReadingsLegacy(t *testing.T) {
+	client := &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/Chassis/1/Thermal": `{
+				"Temperatures": [{
+					"@odata.id": "/redfish/v1/Chassis/1/Thermal#/Temperatures/0",
+					"Name": "Inlet",
+					"PhysicalContext": "Intake",
+					"ReadingCelsius": 24,
+					"UpperThresholdCritical": 40
+				}],
+				"Fans": [{
+					"Name": "Fan 1",
+					"Reading": 30,
+					"ReadingUnits": "Percent",
+					"LowerThresholdCritical": 5
+				}]
+			}`,
+			"GET /redfish/v1/Chassis/1/Power": `{
+				"Voltages": [{"MemberId": "12V", "ReadingVolts": 12.1}],
+				"PowerSupplies": [{
+					"MemberId": "0",
+					"PowerCapacityWatts": 800,
+					"PowerInputWatts": 300,
+					"LastPowerOutputWatts": 280,
+					"LineInputVoltage": 120
+				}]
+			}`,
+		},
+	}
+
+	var chassis Chassis
+	err := json.Unmarshal([]byte(`{
+		"Thermal": {"@odata.id": "/redfish/v1/Chassis/1/Thermal"},
+		"Power": {"@odata.id": "/redfish/v1/Chassis/1/Power"}
+	}`), &chassis)
+	if err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+	chassis.SetClient(client)
+
+	readings, err := chassis.Readings()
+	if err != nil {
+		t.Fatalf("Error getting readings: %s", err)
+	}
+
+	if readings.PowerModel != LegacyReadingsModel || readings.ThermalModel != LegacyReadingsModel {
+		t.Errorf("Expected legacy models, got %s and %s", readings.PowerModel, readings.ThermalModel)
+	}
+
+	if len(readings.Temperatures) != 1 || readings.Temperatures[0].ReadingUnits != "Cel" ||
+		*readings.Temperatures[0].Thresholds.UpperCritical != 40 {
+		t.Errorf("Unexpected temperatures: %+v", readings.Temperatures)
+	}
+
+	if len(readings.Fans) != 1 || readings.Fans[0].ReadingUnits != "%" || *readings.Fans[0].Reading != 30 {
+		t.Errorf("Unexpected fans: %+v", readings.Fans)
+	}
+
+	if len(readings.Voltages) != 1 || readings.Voltages[0].Name != "12V" {
+		t.Errorf("Unexpected voltages: %+v", readings.Voltages)
+	}
+
+	if len(readings.PowerSupplies) != 1 || *readings.PowerSupplies[0].OutputWatts != 280 {
+		t.Errorf("Unexpected power supplies: %+v", readings.PowerSupplies)
+	}
+}
+
+// TestChassisReadingsSubsystemWithoutSensors tests that the voltages of a
+// chassis with a PowerSubsystem but no Sensors come from its Power resource.
+func TestChassisReadingsSubsystemWithoutSensors(t *testing.T) {
+	client := subsystemChassisClient()
+	client.responses["GET /redfish/v1/Chassis/1/Power"] = `{
+		"Voltages": [{"MemberId": "12V", "ReadingVolts": 12.1, "UpperThresholdCritical": 13}]
+	}`
+
+	var chassis Chassis
+	err := json.Unmarshal([]byte(`{
+		"@odata.id": "/redfish/v1/Chassis/1",
+		"Power": {"@odata.id": "/redfish/v1/Chassis/1/Power"},
+		"PowerSubsystem": {"@odata.id": "/redfish/v1/Chassis/1/PowerSubsystem"}
+	}`), &chassis)
+	if err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+	chassis.SetClient(client)
+
+	readings, err := chassis.Readings()
+	if err != nil {
+		t.Fatalf("Error getting readings: %s", err)
+	}
+
+	if readings.PowerModel != SubsystemReadingsModel {
+		t.Errorf("Expected subsystem power model, got %s", readings.PowerModel)
+	}
+
+	if len(readings.Voltages) != 1 || readings.Voltages[0].Name != "12V" ||
+		*readings.Voltages[0].Thresholds.UpperCritical != 13 {
+		t.Errorf("Unexpected voltages: %+v", readings.Voltages)
+	}
+
+	if len(readings.PowerSupplies) != 1 || readings.PowerSupplies[0].Name != "PSU 1" {
+		t.Errorf("Unexpected power supplies: %+v", readings.PowerSupplies)
+	}
+}
@@ -0,0 +1,140 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// Resource is a resource of any type, such as one referenced by a link
+// whose target type is not known in advance.
+type Resource struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// Fragment is the part of the resource that was referenced, as a JSON
+	// pointer such as "/Fans/0". It is empty if the whole resource was
+	// referenced.
+	Fragment string `json:"-"`
+	// rawData holds the original serialized JSON so it can be decoded into
+	// the specific type.
+	rawData []byte
+}
+
+// UnmarshalJSON unmarshals a Resource object from the raw JSON.
+func (resource *Resource) UnmarshalJSON(b []byte) error {
+	type temp Resource
+	var t struct {
+		temp
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*resource = Resource(t.temp)
+
+	// Keep the raw object data so it can be decoded into its specific type
+	resource.rawData = b
+
+	return nil
+}
+
+// GetResource will get a Resource instance from the service. A fragment in
+// the uri is not sent to the service, and is kept in the Fragment field.
+func GetResource(c common.Client, uri string) (*Resource, error) {
+	fragment := ""
+	if i := strings.Index(uri, "#"); i >= 0 {
+		uri, fragment = uri[:i], uri[i+1:]
+	}
+
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var resource Resource
+	err = json.NewDecoder(resp.Body).Decode(&resource)
+	if err != nil {
+		return nil, err
+	}
+
+	resource.Fragment = fragment
+	resource.SetClient(c)
+	return &resource, nil
+}
+
+// ResourceType gets the type name of the resource, such as "Chassis" for a
+// resource of type "#Chassis.v1_10_0.Chassis".
+func (resource *Resource) ResourceType() string {
+	parts := strings.Split(strings.TrimPrefix(resource.ODataType, "#"), ".")
+	return parts[len(parts)-1]
+}
+
+// Decode decodes the resource into the given object.
+func (resource *Resource) Decode(v interface{}) error {
+	return json.Unmarshal(resource.rawData, v)
+}
+
+// clientSetter is implemented by all resources.
+type clientSetter interface {
+	SetClient(common.Client)
+}
+
+// typedResources creates the objects for the resource types Typed knows.
+var typedResources = map[string]func() clientSetter{
+	"Battery":           func() clientSetter { return &Battery{} },
+	"Chassis":           func() clientSetter { return &Chassis{} },
+	"ComputerSystem":    func() clientSetter { return &ComputerSystem{} },
+	"Drive":             func() clientSetter { return &Drive{} },
+	"EthernetInterface": func() clientSetter { return &EthernetInterface{} },
+	"Fan":               func() clientSetter { return &FanUnit{} },
+	"Manager":           func() clientSetter { return &Manager{} },
+	"Memory":            func() clientSetter { return &Memory{} },
+	"NetworkAdapter":    func() clientSetter { return &NetworkAdapter{} },
+	"PCIeDevice":        func() clientSetter { return &PCIeDevice{} },
+	"Power":             func() clientSetter { return &Power{} },
+	"PowerSubsystem":    func() clientSetter { return &PowerSubsystem{} },
+	"PowerSupply":       func() clientSetter { return &PowerSupplyUnit{} },
+	"Processor":         func() clientSetter { return &Processor{} },
+	"Sensor":            func() clientSetter { return &Sensor{} },
+	"Storage":           func() clientSetter { return &Storage{} },
+	"Thermal":           func() clientSetter { return &Thermal{} },
+	"ThermalSubsystem":  func() clientSetter { return &ThermalSubsystem{} },
+}
+
+// Typed decodes the resource into its specific type, such as *Chassis or
+// *Memory. Resources of other types are returned as the *Resource itself.
+func (resource *Resource) Typed() (interface{}, error) {
+	create, ok := typedResources[resource.ResourceType()]
+	if !ok {
+		return resource, nil
+	}
+
+	result := create()
+	err := resource.Decode(result)
+	if err != nil {
+		return nil, err
+	}
+
+	result.SetClient(resource.Client)
+	return result, nil
+}
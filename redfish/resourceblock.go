@@ -0,0 +1,394 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// CompositionState is the state of a resource block with respect to
+// composition.
+type CompositionState string
+
+const (
+	// ComposingCompositionState Intermediate state indicating composition is
+	// in progress.
+	ComposingCompositionState CompositionState = "Composing"
+	// ComposedAndAvailableCompositionState Indicates the Resource Block is
+	// currently participating in one or more compositions, and is available
+	// to use in more compositions.
+	ComposedAndAvailableCompositionState CompositionState = "ComposedAndAvailable"
+	// ComposedCompositionState Final successful state of a Resource Block
+	// which has participated in composition.
+	ComposedCompositionState CompositionState = "Composed"
+	// UnusedCompositionState Indicates the Resource Block is free and can
+	// participate in composition.
+	UnusedCompositionState CompositionState = "Unused"
+	// FailedCompositionState The final composition resulted in failure and
+	// manual intervention is required to fix it.
+	FailedCompositionState CompositionState = "Failed"
+	// UnavailableCompositionState Indicates the Resource Block has been made
+	// unavailable by the service, such as due to maintenance being performed
+	// on the Resource Block.
+	UnavailableCompositionState CompositionState = "Unavailable"
+)
+
+// ResourceBlockType is the type of resources contained in a resource block.
+type ResourceBlockType string
+
+const (
+	// ComputeResourceBlockType This Resource Block contains both Processor
+	// and Memory resources in a manner that creates a compute complex.
+	ComputeResourceBlockType ResourceBlockType = "Compute"
+	// ProcessorResourceBlockType This Resource Block contains Processor
+	// resources.
+	ProcessorResourceBlockType ResourceBlockType = "Processor"
+	// MemoryResourceBlockType This Resource Block contains Memory resources.
+	MemoryResourceBlockType ResourceBlockType = "Memory"
+	// NetworkResourceBlockType This Resource Block contains Network resources,
+	// such as Ethernet Interfaces.
+	NetworkResourceBlockType ResourceBlockType = "Network"
+	// StorageResourceBlockType This Resource Block contains Storage
+	// resources, such as Storage and Simple Storage.
+	StorageResourceBlockType ResourceBlockType = "Storage"
+	// ComputerSystemResourceBlockType This Resource Block contains
+	// ComputerSystem resources.
+	ComputerSystemResourceBlockType ResourceBlockType = "ComputerSystem"
+	// ExpansionResourceBlockType This Resource Block is capable of changing
+	// over time based on its configuration.
+	ExpansionResourceBlockType ResourceBlockType = "Expansion"
+)
+
+// CompositionStatus shall contain properties that describe the high level
+// composition status of the resource block.
+type CompositionStatus struct {
+	// CompositionState shall be an enumerated value describing the
+	// composition state of the Resource Block.
+	CompositionState CompositionState
+	// MaxCompositions shall be a number indicating the maximum number of
+	// compositions in which this Resource Block is capable of participating
+	// simultaneously.
+	MaxCompositions int
+	// NumberOfCompositions shall be the number of compositions in which this
+	// Resource Block is currently participating.
+	NumberOfCompositions int
+	// Reserved shall be a boolean that is set by client once the Resource
+	// Block has been identified by the client.
+	Reserved bool
+	// SharingCapable shall be a boolean indicating whether this Resource
+	// Block is capable of participating in multiple compositions
+	// simultaneously.
+	SharingCapable bool
+	// SharingEnabled shall be a boolean indicating whether this Resource
+	// Block is allowed to participate in multiple compositions
+	// simultaneously.
+	SharingEnabled bool
+}
+
+// ResourceBlock is used to represent a Resource Block for a Redfish
+// implementation.
+type ResourceBlock struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// CompositionStatus shall contain composition status information about
+	// this Resource Block.
+	CompositionStatus CompositionStatus
+	// Description provides a description of this resource.
+	Description string
+	// ResourceBlockType shall contain an array of enumerated values that
+	// describe the type of resources available.
+	ResourceBlockType []ResourceBlockType
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// computerSystems shall be an array of references of type
+	// ComputerSystem that are in this Resource Block.
+	computerSystems []string
+	// drives shall be an array of references of type Drive that are in this
+	// Resource Block.
+	drives []string
+	// ethernetInterfaces shall be an array of references of type
+	// EthernetInterface that are in this Resource Block.
+	ethernetInterfaces []string
+	// memory shall be an array of references of type Memory that are in this
+	// Resource Block.
+	memory []string
+	// networkInterfaces shall be an array of references of type
+	// NetworkInterface that are in this Resource Block.
+	networkInterfaces []string
+	// processors shall be an array of references of type Processor that are
+	// in this Resource Block.
+	processors []string
+	// simpleStorage shall be an array of references of type SimpleStorage
+	// that are in this Resource Block.
+	simpleStorage []string
+	// storage shall be an array of references of type Storage that are in
+	// this Resource Block.
+	storage []string
+	// chassis shall be an array of references of type Chassis that represent
+	// the physical container associated with this Resource Block.
+	chassis []string
+	// composedSystems shall be an array of references of type ComputerSystem
+	// that represent the systems composed from this Resource Block.
+	composedSystems []string
+	// zones shall be an array of references of type Zone that represent the
+	// Resource Zones this Resource Block belongs to.
+	zones []string
+}
+
+// UnmarshalJSON unmarshals a ResourceBlock object from the raw JSON.
+func (resourceblock *ResourceBlock) UnmarshalJSON(b []byte) error {
+	type temp ResourceBlock
+	type links struct {
+		Chassis         common.Links
+		ComputerSystems common.Links
+		Zones           common.Links
+	}
+	var t struct {
+		temp
+		ComputerSystems    common.Links
+		Drives             common.Links
+		EthernetInterfaces common.Links
+		Memory             common.Links
+		NetworkInterfaces  common.Links
+		Processors         common.Links
+		SimpleStorage      common.Links
+		Storage            common.Links
+		Links              links
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*resourceblock = ResourceBlock(t.temp)
+
+	// Extract the links to other entities for later
+	resourceblock.computerSystems = t.ComputerSystems.ToStrings()
+	resourceblock.drives = t.Drives.ToStrings()
+	resourceblock.ethernetInterfaces = t.EthernetInterfaces.ToStrings()
+	resourceblock.memory = t.Memory.ToStrings()
+	resourceblock.networkInterfaces = t.NetworkInterfaces.ToStrings()
+	resourceblock.processors = t.Processors.ToStrings()
+	resourceblock.simpleStorage = t.SimpleStorage.ToStrings()
+	resourceblock.storage = t.Storage.ToStrings()
+	resourceblock.chassis = t.Links.Chassis.ToStrings()
+	resourceblock.composedSystems = t.Links.ComputerSystems.ToStrings()
+	resourceblock.zones = t.Links.Zones.ToStrings()
+
+	return nil
+}
+
+// GetResourceBlock will get a ResourceBlock instance from the service.
+func GetResourceBlock(c common.Client, uri string) (*ResourceBlock, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var resourceblock ResourceBlock
+	err = json.NewDecoder(resp.Body).Decode(&resourceblock)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceblock.SetClient(c)
+	return &resourceblock, nil
+}
+
+// ListReferencedResourceBlocks gets the collection of ResourceBlock from
+// a provided reference.
+func ListReferencedResourceBlocks(c common.Client, link string) ([]*ResourceBlock, error) {
+	var result []*ResourceBlock
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, resourceblockLink := range links.ItemLinks {
+		resourceblock, err := GetResourceBlock(c, resourceblockLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, resourceblock)
+	}
+
+	return result, nil
+}
+
+// ComputerSystems gets the computer systems contained in the resource block.
+func (resourceblock *ResourceBlock) ComputerSystems() ([]*ComputerSystem, error) {
+	return resourceblock.getComputerSystems(resourceblock.computerSystems)
+}
+
+// ComposedSystems gets the computer systems composed from the resource block.
+func (resourceblock *ResourceBlock) ComposedSystems() ([]*ComputerSystem, error) {
+	return resourceblock.getComputerSystems(resourceblock.composedSystems)
+}
+
+func (resourceblock *ResourceBlock) getComputerSystems(links []string) ([]*ComputerSystem, error) {
+	var result []*ComputerSystem
+	for _, link := range links {
+		computersystem, err := GetComputerSystem(resourceblock.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, computersystem)
+	}
+
+	return result, nil
+}
+
+// Drives gets the drives contained in the resource block.
+func (resourceblock *ResourceBlock) Drives() ([]*Drive, error) {
+	var result []*Drive
+	for _, link := range resourceblock.drives {
+		drive, err := GetDrive(resourceblock.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, drive)
+	}
+
+	return result, nil
+}
+
+// EthernetInterfaces gets the ethernet interfaces contained in the resource
+// block.
+func (resourceblock *ResourceBlock) EthernetInterfaces() ([]*EthernetInterface, error) {
+	var result []*EthernetInterface
+	for _, link := range resourceblock.ethernetInterfaces {
+		ethernetinterface, err := GetEthernetInterface(resourceblock.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, ethernetinterface)
+	}
+
+	return result, nil
+}
+
+// Memory gets the memory contained in the resource block.
+func (resourceblock *ResourceBlock) Memory() ([]*Memory, error) {
+	var result []*Memory
+	for _, link := range resourceblock.memory {
+		memory, err := GetMemory(resourceblock.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, memory)
+	}
+
+	return result, nil
+}
+
+// NetworkInterfaces gets the network interfaces contained in the resource
+// block.
+func (resourceblock *ResourceBlock) NetworkInterfaces() ([]*NetworkInterface, error) {
+	var result []*NetworkInterface
+	for _, link := range resourceblock.networkInterfaces {
+		networkinterface, err := GetNetworkInterface(resourceblock.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, networkinterface)
+	}
+
+	return result, nil
+}
+
+// Processors gets the processors contained in the resource block.
+func (resourceblock *ResourceBlock) Processors() ([]*Processor, error) {
+	var result []*Processor
+	for _, link := range resourceblock.processors {
+		processor, err := GetProcessor(resourceblock.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, processor)
+	}
+
+	return result, nil
+}
+
+// SimpleStorages gets the simple storage contained in the resource block.
+func (resourceblock *ResourceBlock) SimpleStorages() ([]*SimpleStorage, error) {
+	var result []*SimpleStorage
+	for _, link := range resourceblock.simpleStorage {
+		simplestorage, err := GetSimpleStorage(resourceblock.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, simplestorage)
+	}
+
+	return result, nil
+}
+
+// Storage gets the storage contained in the resource block.
+func (resourceblock *ResourceBlock) Storage() ([]*Storage, error) {
+	var result []*Storage
+	for _, link := range resourceblock.storage {
+		storage, err := GetStorage(resourceblock.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, storage)
+	}
+
+	return result, nil
+}
+
+// Chassis gets the chassis the resource block is located in.
+func (resourceblock *ResourceBlock) Chassis() ([]*Chassis, error) {
+	var result []*Chassis
+	for _, link := range resourceblock.chassis {
+		chassis, err := GetChassis(resourceblock.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, chassis)
+	}
+
+	return result, nil
+}
+
+// Zones gets the resource zones the resource block belongs to.
+func (resourceblock *ResourceBlock) Zones() ([]*Zone, error) {
+	var result []*Zone
+	for _, link := range resourceblock.zones {
+		zone, err := GetZone(resourceblock.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, zone)
+	}
+
+	return result, nil
+}
+
+// resourceBlockLinks gets the links to the given resource blocks.
+func resourceBlockLinks(blocks []*ResourceBlock) common.Links {
+	links := common.Links{}
+	for _, block := range blocks {
+		links = append(links, common.Link(block.ODataID))
+	}
+	return links
+}
@@ -0,0 +1,118 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var resourceBlockBody = `{
+		"@odata.type": "#ResourceBlock.v1_3_0.ResourceBlock",
+		"@odata.id": "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1",
+		"Id": "ComputeBlock1",
+		"Name": "Compute Block 1",
+		"ResourceBlockType": [
+			"Compute"
+		],
+		"Status": {
+			"State": "Enabled",
+			"Health": "OK"
+		},
+		"CompositionStatus": {
+			"Reserved": false,
+			"CompositionState": "Unused",
+			"SharingCapable": false,
+			"MaxCompositions": 1,
+			"NumberOfCompositions": 0
+		},
+		"Processors": [
+			{
+				"@odata.id": "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1/Processors/CPU1"
+			},
+			{
+				"@odata.id": "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1/Processors/CPU2"
+			}
+		],
+		"Memory": [
+			{
+				"@odata.id": "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1/Memory/DIMM1"
+			}
+		],
+		"Drives": [
+			{
+				"@odata.id": "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1/Drives/Drive1"
+			}
+		],
+		"NetworkInterfaces": [
+			{
+				"@odata.id": "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1/NetworkInterfaces/NIC1"
+			}
+		],
+		"Links": {
+			"ComputerSystems": [],
+			"Chassis": [
+				{
+					"@odata.id": "/redfish/v1/Chassis/ComposableModule1"
+				}
+			],
+			"Zones": [
+				{
+					"@odata.id": "/redfish/v1/CompositionService/ResourceZones/1"
+				}
+			]
+		}
+	}`
+
+// TestResourceBlock tests the parsing of ResourceBlock objects.
+func TestResourceBlock(t *testing.T) {
+	var result ResourceBlock
+	err := json.NewDecoder(strings.NewReader(resourceBlockBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "ComputeBlock1" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if len(result.ResourceBlockType) != 1 || result.ResourceBlockType[0] != ComputeResourceBlockType {
+		t.Errorf("Invalid resource block type: %v", result.ResourceBlockType)
+	}
+
+	if result.CompositionStatus.CompositionState != UnusedCompositionState {
+		t.Errorf("Invalid composition state: %s", result.CompositionStatus.CompositionState)
+	}
+
+	if result.CompositionStatus.MaxCompositions != 1 {
+		t.Errorf("Invalid max compositions: %d", result.CompositionStatus.MaxCompositions)
+	}
+
+	if len(result.processors) != 2 {
+		t.Errorf("Invalid processors: %v", result.processors)
+	}
+
+	if result.memory[0] != "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1/Memory/DIMM1" {
+		t.Errorf("Invalid memory: %v", result.memory)
+	}
+
+	if result.drives[0] != "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1/Drives/Drive1" {
+		t.Errorf("Invalid drives: %v", result.drives)
+	}
+
+	if len(result.networkInterfaces) != 1 {
+		t.Errorf("Invalid network interfaces: %v", result.networkInterfaces)
+	}
+
+	if len(result.composedSystems) != 0 {
+		t.Errorf("Invalid composed systems: %v", result.composedSystems)
+	}
+
+	if result.zones[0] != "/redfish/v1/CompositionService/ResourceZones/1" {
+		t.Errorf("Invalid zones: %v", result.zones)
+	}
+}
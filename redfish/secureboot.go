@@ -6,6 +6,7 @@ package redfish
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/rocksolidlabs/gofish/common"
 )
@@ -75,6 +76,81 @@ type SecureBoot struct {
 	// SecureBootMode shall contain the current Secure Boot mode, as defined in
 	// the UEFI Specification.
 	SecureBootMode SecureBootModeType
+	// resetKeysTarget is the URL to send ResetKeys requests.
+	resetKeysTarget string
+	// secureBootDatabases shall contain a link to a resource collection of
+	// type SecureBootDatabaseCollection.
+	secureBootDatabases string
+}
+
+// UnmarshalJSON unmarshals a SecureBoot object from the raw JSON.
+func (secureboot *SecureBoot) UnmarshalJSON(b []byte) error {
+	type temp SecureBoot
+	type actions struct {
+		ResetKeys struct {
+			Target string
+		} `json:"#SecureBoot.ResetKeys"`
+	}
+	var t struct {
+		temp
+		Actions             actions
+		SecureBootDatabases common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*secureboot = SecureBoot(t.temp)
+
+	// Extract the links to other entities for later
+	secureboot.resetKeysTarget = t.Actions.ResetKeys.Target
+	secureboot.secureBootDatabases = string(t.SecureBootDatabases)
+
+	return nil
+}
+
+// SetEnabled enables or disables UEFI Secure Boot. The change typically
+// takes effect on the next boot.
+func (secureboot *SecureBoot) SetEnabled(enabled bool) error {
+	t := struct {
+		SecureBootEnable bool
+	}{SecureBootEnable: enabled}
+
+	resp, err := secureboot.Patch(secureboot.ODataID, t)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	secureboot.SecureBootEnable = enabled
+	return nil
+}
+
+// ResetKeys resets the content of the UEFI Secure Boot key databases.
+func (secureboot *SecureBoot) ResetKeys(resetType ResetKeysType) error {
+	if secureboot.resetKeysTarget == "" {
+		return fmt.Errorf("ResetKeys is not supported by this service")
+	}
+
+	t := struct {
+		ResetKeysType ResetKeysType
+	}{ResetKeysType: resetType}
+
+	resp, err := secureboot.Post(secureboot.resetKeysTarget, t)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// SecureBootDatabases gets the UEFI Secure Boot databases, such as PK, KEK,
+// db and dbx.
+func (secureboot *SecureBoot) SecureBootDatabases() ([]*SecureBootDatabase, error) {
+	return ListReferencedSecureBootDatabases(secureboot.Client, secureboot.secureBootDatabases)
 }
 
 // GetSecureBoot will get a SecureBoot instance from the service.
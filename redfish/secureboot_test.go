@@ -52,3 +52,55 @@ func TestSecureBoot(t *testing.T) {
 		t.Errorf("Invalid SecureBootMode: %s", result.SecureBootMode)
 	}
 }
+
+// TestSecureBootActions tests enabling Secure Boot and resetting its keys.
+func TestSecureBootActions(t *testing.T) {
+	var result SecureBoot
+	err := json.NewDecoder(strings.NewReader(`{
+		"@odata.id": "/redfish/v1/Systems/1/SecureBoot",
+		"Id": "SecureBoot",
+		"SecureBootEnable": false,
+		"SecureBootMode": "SetupMode",
+		"SecureBootDatabases": {
+			"@odata.id": "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases"
+		},
+		"Actions": {
+			"#SecureBoot.ResetKeys": {
+				"target": "/redfish/v1/Systems/1/SecureBoot/Actions/SecureBoot.ResetKeys"
+			}
+		}
+	}`)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.secureBootDatabases != "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases" {
+		t.Errorf("Invalid secure boot databases link: %s", result.secureBootDatabases)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	err = result.SetEnabled(true)
+	if err != nil {
+		t.Errorf("Error enabling secure boot: %s", err)
+	}
+
+	if client.calls[0].URL != "/redfish/v1/Systems/1/SecureBoot" || client.calls[0].Payload != `{"SecureBootEnable":true}` {
+		t.Errorf("Unexpected enable request: %s %s", client.calls[0].URL, client.calls[0].Payload)
+	}
+
+	if !result.SecureBootEnable {
+		t.Error("SecureBootEnable should be true")
+	}
+
+	err = result.ResetKeys(ResetAllKeysToDefaultResetKeysType)
+	if err != nil {
+		t.Errorf("Error resetting keys: %s", err)
+	}
+
+	if client.calls[1].URL != "/redfish/v1/Systems/1/SecureBoot/Actions/SecureBoot.ResetKeys" ||
+		client.calls[1].Payload != `{"ResetKeysType":"ResetAllKeysToDefault"}` {
+		t.Errorf("Unexpected reset request: %s %s", client.calls[1].URL, client.calls[1].Payload)
+	}
+}
@@ -0,0 +1,237 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// SecureBootDatabaseResetKeysType is the method for resetting the keys of a
+// single UEFI Secure Boot database.
+type SecureBootDatabaseResetKeysType string
+
+const (
+	// ResetAllKeysToDefaultSecureBootDatabaseResetKeysType Reset the content
+	// of this UEFI Secure Boot key database to the default values.
+	ResetAllKeysToDefaultSecureBootDatabaseResetKeysType SecureBootDatabaseResetKeysType = "ResetAllKeysToDefault"
+	// DeleteAllKeysSecureBootDatabaseResetKeysType Delete the content of this
+	// UEFI Secure Boot key database.
+	DeleteAllKeysSecureBootDatabaseResetKeysType SecureBootDatabaseResetKeysType = "DeleteAllKeys"
+)
+
+// SecureBootDatabase is used to represent a UEFI Secure Boot database, such
+// as PK, KEK, db or dbx.
+type SecureBootDatabase struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// DatabaseID shall contain the name of the UEFI Secure Boot database,
+	// such as PK, KEK, db, dbx or their default variants.
+	DatabaseID string `json:"DatabaseId"`
+	// Description provides a description of this resource.
+	Description string
+	// ResetKeysTypes are the allowed values for the ResetKeys action.
+	ResetKeysTypes []SecureBootDatabaseResetKeysType
+	// certificates shall contain a link to a resource collection of type
+	// CertificateCollection.
+	certificates string
+	// resetKeysTarget is the URL to send ResetKeys requests.
+	resetKeysTarget string
+	// signatures shall contain a link to a resource collection of type
+	// SignatureCollection.
+	signatures string
+}
+
+// UnmarshalJSON unmarshals a SecureBootDatabase object from the raw JSON.
+func (database *SecureBootDatabase) UnmarshalJSON(b []byte) error {
+	type temp SecureBootDatabase
+	type actions struct {
+		ResetKeys struct {
+			AllowableValues []SecureBootDatabaseResetKeysType `json:"ResetKeysType@Redfish.AllowableValues"`
+			Target          string
+		} `json:"#SecureBootDatabase.ResetKeys"`
+	}
+	var t struct {
+		temp
+		Actions      actions
+		Certificates common.Link
+		Signatures   common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*database = SecureBootDatabase(t.temp)
+
+	// Extract the links to other entities for later
+	database.ResetKeysTypes = t.Actions.ResetKeys.AllowableValues
+	database.resetKeysTarget = t.Actions.ResetKeys.Target
+	database.certificates = string(t.Certificates)
+	database.signatures = string(t.Signatures)
+
+	return nil
+}
+
+// GetSecureBootDatabase will get a SecureBootDatabase instance from the
+// service.
+func GetSecureBootDatabase(c common.Client, uri string) (*SecureBootDatabase, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var database SecureBootDatabase
+	err = json.NewDecoder(resp.Body).Decode(&database)
+	if err != nil {
+		return nil, err
+	}
+
+	database.SetClient(c)
+	return &database, nil
+}
+
+// ListReferencedSecureBootDatabases gets the collection of SecureBootDatabase
+// from a provided reference.
+func ListReferencedSecureBootDatabases(c common.Client, link string) ([]*SecureBootDatabase, error) {
+	var result []*SecureBootDatabase
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, databaseLink := range links.ItemLinks {
+		database, err := GetSecureBootDatabase(c, databaseLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, database)
+	}
+
+	return result, nil
+}
+
+// Certificates gets the certificates in the database.
+func (database *SecureBootDatabase) Certificates() ([]*Certificate, error) {
+	return ListReferencedCertificates(database.Client, database.certificates)
+}
+
+// Signatures gets the signatures in the database.
+func (database *SecureBootDatabase) Signatures() ([]*Signature, error) {
+	return ListReferencedSignatures(database.Client, database.signatures)
+}
+
+// AddCertificate adds a certificate to the database. The owner is the GUID
+// of the UEFI signature owner and may be empty.
+func (database *SecureBootDatabase) AddCertificate(certificateString string, certificateType CertificateType, owner string) (*Certificate, error) {
+	if database.certificates == "" {
+		return nil, fmt.Errorf("database %s does not support certificates", database.DatabaseID)
+	}
+
+	t := struct {
+		CertificateString  string
+		CertificateType    CertificateType
+		UefiSignatureOwner string `json:",omitempty"`
+	}{
+		CertificateString:  certificateString,
+		CertificateType:    certificateType,
+		UefiSignatureOwner: owner,
+	}
+
+	resp, err := database.Post(database.certificates, t)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if location := resp.Header.Get("Location"); location != "" {
+		return GetCertificate(database.Client, location)
+	}
+
+	var certificate Certificate
+	err = json.NewDecoder(resp.Body).Decode(&certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate.SetClient(database.Client)
+	return &certificate, nil
+}
+
+// AddSignature adds a UEFI signature, such as the hash of a revoked binary
+// for dbx, to the database. The signature type is the UEFI signature GUID
+// name, such as EFI_CERT_SHA256_GUID. The owner is the GUID of the UEFI
+// signature owner and may be empty.
+func (database *SecureBootDatabase) AddSignature(signatureString, signatureType, owner string) (*Signature, error) {
+	if database.signatures == "" {
+		return nil, fmt.Errorf("database %s does not support signatures", database.DatabaseID)
+	}
+
+	t := struct {
+		SignatureString       string
+		SignatureType         string
+		SignatureTypeRegistry SignatureTypeRegistry
+		UefiSignatureOwner    string `json:",omitempty"`
+	}{
+		SignatureString:       signatureString,
+		SignatureType:         signatureType,
+		SignatureTypeRegistry: UEFISignatureTypeRegistry,
+		UefiSignatureOwner:    owner,
+	}
+
+	resp, err := database.Post(database.signatures, t)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if location := resp.Header.Get("Location"); location != "" {
+		return GetSignature(database.Client, location)
+	}
+
+	var signature Signature
+	err = json.NewDecoder(resp.Body).Decode(&signature)
+	if err != nil {
+		return nil, err
+	}
+
+	signature.SetClient(database.Client)
+	return &signature, nil
+}
+
+// ResetKeys resets the content of the database.
+func (database *SecureBootDatabase) ResetKeys(resetType SecureBootDatabaseResetKeysType) error {
+	if database.resetKeysTarget == "" {
+		return fmt.Errorf("ResetKeys is not supported by this service")
+	}
+
+	t := struct {
+		ResetKeysType SecureBootDatabaseResetKeysType
+	}{ResetKeysType: resetType}
+
+	resp, err := database.Post(database.resetKeysTarget, t)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,146 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+var secureBootDatabaseBody = `{
+		"@odata.type": "#SecureBootDatabase.v1_0_1.SecureBootDatabase",
+		"@odata.id": "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx",
+		"Id": "dbx",
+		"Name": "dbx - Forbidden Signature Database",
+		"Description": "UEFI dbx Secure Boot Database",
+		"DatabaseId": "dbx",
+		"Certificates": {
+			"@odata.id": "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx/Certificates"
+		},
+		"Signatures": {
+			"@odata.id": "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx/Signatures"
+		},
+		"Actions": {
+			"#SecureBootDatabase.ResetKeys": {
+				"target": "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx/Actions/SecureBootDatabase.ResetKeys",
+				"ResetKeysType@Redfish.AllowableValues": [
+					"ResetAllKeysToDefault",
+					"DeleteAllKeys"
+				]
+			}
+		}
+	}`
+
+// TestSecureBootDatabase tests the parsing of SecureBootDatabase objects.
+func TestSecureBootDatabase(t *testing.T) {
+	var result SecureBootDatabase
+	err := json.NewDecoder(strings.NewReader(secureBootDatabaseBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.DatabaseID != "dbx" {
+		t.Errorf("Received invalid database ID: %s", result.DatabaseID)
+	}
+
+	if len(result.ResetKeysTypes) != 2 || result.ResetKeysTypes[1] != DeleteAllKeysSecureBootDatabaseResetKeysType {
+		t.Errorf("Invalid reset keys types: %v", result.ResetKeysTypes)
+	}
+
+	if result.certificates != "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx/Certificates" {
+		t.Errorf("Invalid certificates link: %s", result.certificates)
+	}
+
+	if result.signatures != "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx/Signatures" {
+		t.Errorf("Invalid signatures link: %s", result.signatures)
+	}
+}
+
+// TestSecureBootDatabaseUpdates tests adding and removing database entries.
+func TestSecureBootDatabaseUpdates(t *testing.T) {
+	var result SecureBootDatabase
+	err := json.NewDecoder(strings.NewReader(secureBootDatabaseBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	signatures := "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx/Signatures"
+	client := &testClient{
+		responses: map[string]string{
+			"GET " + signatures + "/1": `{
+				"@odata.id": "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx/Signatures/1",
+				"Id": "1",
+				"SignatureString": "80B4D96931BF0D02FD91A61E19D14F1DA452E66DB2408CA8604D411F92659F0A",
+				"SignatureType": "EFI_CERT_SHA256_GUID",
+				"SignatureTypeRegistry": "UEFI",
+				"UefiSignatureOwner": "28d5e212-165b-4ca0-909b-c86b9cee0112"
+			}`,
+			"POST /redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx/Certificates": `{
+				"@odata.id": "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx/Certificates/1",
+				"Id": "1",
+				"CertificateType": "PEM"
+			}`,
+		},
+		headers: map[string]http.Header{
+			"POST " + signatures: {"Location": []string{signatures + "/1"}},
+		},
+	}
+	result.SetClient(client)
+
+	signature, err := result.AddSignature("80B4D96931BF0D02FD91A61E19D14F1DA452E66DB2408CA8604D411F92659F0A",
+		"EFI_CERT_SHA256_GUID", "28d5e212-165b-4ca0-909b-c86b9cee0112")
+	if err != nil {
+		t.Fatalf("Error adding signature: %s", err)
+	}
+
+	expected := `{"SignatureString":"80B4D96931BF0D02FD91A61E19D14F1DA452E66DB2408CA8604D411F92659F0A",` +
+		`"SignatureType":"EFI_CERT_SHA256_GUID","SignatureTypeRegistry":"UEFI","UefiSignatureOwner":"28d5e212-165b-4ca0-909b-c86b9cee0112"}`
+	if client.calls[0].URL != signatures || client.calls[0].Payload != expected {
+		t.Errorf("Unexpected add signature request: %s %s", client.calls[0].URL, client.calls[0].Payload)
+	}
+
+	if signature.SignatureTypeRegistry != UEFISignatureTypeRegistry {
+		t.Errorf("Invalid signature type registry: %s", signature.SignatureTypeRegistry)
+	}
+
+	err = signature.Delete()
+	if err != nil {
+		t.Errorf("Error deleting signature: %s", err)
+	}
+
+	if client.calls[2].Method != http.MethodDelete || client.calls[2].URL != signatures+"/1" {
+		t.Errorf("Unexpected delete request: %s %s", client.calls[2].Method, client.calls[2].URL)
+	}
+
+	certificate, err := result.AddCertificate("-----BEGIN CERTIFICATE-----", PEMCertificateType, "")
+	if err != nil {
+		t.Fatalf("Error adding certificate: %s", err)
+	}
+
+	if client.calls[3].Payload != `{"CertificateString":"-----BEGIN CERTIFICATE-----","CertificateType":"PEM"}` {
+		t.Errorf("Unexpected add certificate payload: %s", client.calls[3].Payload)
+	}
+
+	err = certificate.Delete()
+	if err != nil {
+		t.Errorf("Error deleting certificate: %s", err)
+	}
+
+	if client.calls[4].URL != "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/dbx/Certificates/1" {
+		t.Errorf("Unexpected delete request: %s", client.calls[4].URL)
+	}
+
+	err = result.ResetKeys(DeleteAllKeysSecureBootDatabaseResetKeysType)
+	if err != nil {
+		t.Errorf("Error resetting keys: %s", err)
+	}
+
+	if client.calls[5].Payload != `{"ResetKeysType":"DeleteAllKeys"}` {
+		t.Errorf("Unexpected reset payload: %s", client.calls[5].Payload)
+	}
+}
@@ -0,0 +1,215 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SELEventCategory is a normalized category of a SEL or OEM log entry.
+type SELEventCategory string
+
+const (
+	// MemoryECCSELEventCategory A correctable or uncorrectable memory ECC
+	// error.
+	MemoryECCSELEventCategory SELEventCategory = "MemoryECC"
+	// PowerSupplyFailureSELEventCategory A power supply or power unit has
+	// failed.
+	PowerSupplyFailureSELEventCategory SELEventCategory = "PowerSupplyFailure"
+	// FanFailureSELEventCategory A fan or cooling device has failed or
+	// dropped below its critical speed.
+	FanFailureSELEventCategory SELEventCategory = "FanFailure"
+	// ThermalTripSELEventCategory A component reached a temperature at which
+	// it shuts down to prevent damage.
+	ThermalTripSELEventCategory SELEventCategory = "ThermalTrip"
+	// OtherSELEventCategory The entry does not belong to a known category.
+	OtherSELEventCategory SELEventCategory = "Other"
+)
+
+// IPMI sensor-specific event offsets, from table 42-3 of the IPMI
+// specification.
+const (
+	memoryCorrectableECCOffset         = 0x00
+	memoryUncorrectableECCOffset       = 0x01
+	memoryCorrectableECCLimitOffset    = 0x05
+	processorThermalTripOffset         = 0x01
+	powerSupplyFailureOffset           = 0x01
+	powerUnitFailureOffset             = 0x06
+	noSensorSpecificOffset             = -1
+	eventDataSensorSpecificOffsetMask  = 0x0f
+	eventDataSensorSpecificOffsetShift = 16
+)
+
+// SELEvent is a log entry decoded into a normalized category.
+type SELEvent struct {
+	// Category is the normalized category of the entry.
+	Category SELEventCategory
+	// Asserted is false if the entry reports that the condition cleared.
+	Asserted bool
+	// SensorType is the IPMI sensor type of the entry, if any.
+	SensorType SensorType
+	// SensorNumber is the IPMI sensor number of the entry, if any.
+	SensorNumber int
+	// Offset is the sensor-specific event offset from the event data, or
+	// -1 if the entry does not have one.
+	Offset int
+}
+
+// SELDecoder turns SEL and OEM log entries into normalized categories. SEL
+// entries are decoded from their sensor type, entry code and event data.
+// Entries in OEM formats are decoded by the functions added for the format,
+// falling back to matching well-known phrases in the message. A SELDecoder
+// is safe for concurrent use.
+type SELDecoder struct {
+	mutex       sync.RWMutex
+	oemDecoders map[string]func(*LogEntry) SELEventCategory
+}
+
+// NewSELDecoder creates a decoder without OEM decoders.
+func NewSELDecoder() *SELDecoder {
+	return &SELDecoder{oemDecoders: make(map[string]func(*LogEntry) SELEventCategory)}
+}
+
+// AddOEMDecoder adds a function that categorizes entries with the given
+// OemRecordFormat, or SEL entries with the given OemSensorType. The function
+// should return OtherSELEventCategory for entries it does not know.
+func (decoder *SELDecoder) AddOEMDecoder(format string, decode func(*LogEntry) SELEventCategory) {
+	decoder.mutex.Lock()
+	defer decoder.mutex.Unlock()
+
+	decoder.oemDecoders[format] = decode
+}
+
+// Decode categorizes the log entry. Entries that are neither SEL nor OEM
+// entries are in OtherSELEventCategory.
+func (decoder *SELDecoder) Decode(entry *LogEntry) *SELEvent {
+	event := &SELEvent{
+		Category:     OtherSELEventCategory,
+		Asserted:     entry.EntryCode != DeassertLogEntryCode,
+		SensorType:   entry.SensorType,
+		SensorNumber: entry.SensorNumber,
+		Offset:       noSensorSpecificOffset,
+	}
+
+	switch entry.EntryType {
+	case SELLogEntryType:
+		if entry.SensorType == OEMSensorType {
+			event.Category = decoder.decodeOEM(entry.OemSensorType, entry)
+			return event
+		}
+		if entry.EntryCode == AssertLogEntryCode || entry.EntryCode == DeassertLogEntryCode {
+			event.Offset = sensorSpecificOffset(entry.MessageID)
+		}
+		event.Category = decodeSEL(entry.SensorType, entry.EntryCode, event.Offset)
+	case OemLogEntryType:
+		event.Category = decoder.decodeOEM(entry.OemRecordFormat, entry)
+	}
+
+	return event
+}
+
+// decodeOEM categorizes an entry in an OEM format.
+func (decoder *SELDecoder) decodeOEM(format string, entry *LogEntry) SELEventCategory {
+	decoder.mutex.RLock()
+	decode, ok := decoder.oemDecoders[format]
+	decoder.mutex.RUnlock()
+
+	if ok {
+		return decode(entry)
+	}
+	return decodeOEMMessage(entry.Message)
+}
+
+// decodeSEL categorizes a SEL entry from its sensor type, entry code and
+// sensor-specific offset.
+func decodeSEL(sensorType SensorType, code LogEntryCode, offset int) SELEventCategory {
+	switch sensorType {
+	case MemorySensorType:
+		switch offset {
+		case memoryCorrectableECCOffset, memoryUncorrectableECCOffset, memoryCorrectableECCLimitOffset:
+			return MemoryECCSELEventCategory
+		}
+	case PowerSupplyConverterSensorType:
+		if offset == powerSupplyFailureOffset || isCriticalTransition(code) {
+			return PowerSupplyFailureSELEventCategory
+		}
+	case PowerUnitSensorType:
+		if offset == powerUnitFailureOffset {
+			return PowerSupplyFailureSELEventCategory
+		}
+	case FanSensorType, CoolingDeviceSensorType:
+		switch code {
+		case LowerCriticalGoingLowLogEntryCode, LowerNonRecoverableGoingLowLogEntryCode:
+			return FanFailureSELEventCategory
+		}
+		if isCriticalTransition(code) {
+			return FanFailureSELEventCategory
+		}
+	case TemperatureSensorType:
+		if code == UpperNonRecoverableGoingHighLogEntryCode {
+			return ThermalTripSELEventCategory
+		}
+	case ProcessorSensorType:
+		if offset == processorThermalTripOffset {
+			return ThermalTripSELEventCategory
+		}
+	}
+
+	return OtherSELEventCategory
+}
+
+// isCriticalTransition indicates whether the entry code is a discrete
+// transition to a critical or non-recoverable state.
+func isCriticalTransition(code LogEntryCode) bool {
+	switch code {
+	case TransitionToCriticalFromLessSevereLogEntryCode,
+		TransitionToNonrecoverableFromLessSevereLogEntryCode,
+		TransitionToNonrecoverableLogEntryCode:
+		return true
+	}
+	return false
+}
+
+// sensorSpecificOffset gets the sensor-specific event offset from the low
+// nibble of Event Data 1, which services report in the MessageId of SEL
+// entries as "0x" followed by the three event data bytes.
+func sensorSpecificOffset(messageID string) int {
+	if len(messageID) != 8 || !strings.HasPrefix(strings.ToLower(messageID), "0x") {
+		return noSensorSpecificOffset
+	}
+
+	data, err := strconv.ParseUint(messageID[2:], 16, 32)
+	if err != nil {
+		return noSensorSpecificOffset
+	}
+
+	return int(data>>eventDataSensorSpecificOffsetShift) & eventDataSensorSpecificOffsetMask
+}
+
+// oemMessagePatterns match phrases commonly used by OEM log formats, and
+// are checked in order against the lower case message.
+var oemMessagePatterns = []struct {
+	pattern  *regexp.Regexp
+	category SELEventCategory
+}{
+	{regexp.MustCompile(`\bthermal trip`), ThermalTripSELEventCategory},
+	{regexp.MustCompile(`\becc\b`), MemoryECCSELEventCategory},
+	{regexp.MustCompile(`\b(power supply|psu)\b.*\b(fail|fault)`), PowerSupplyFailureSELEventCategory},
+	{regexp.MustCompile(`\bfan\b.*\b(fail|fault)`), FanFailureSELEventCategory},
+}
+
+// decodeOEMMessage categorizes an OEM entry by the phrases in its message.
+func decodeOEMMessage(message string) SELEventCategory {
+	message = strings.ToLower(message)
+	for _, p := range oemMessagePatterns {
+		if p.pattern.MatchString(message) {
+			return p.category
+		}
+	}
+	return OtherSELEventCategory
+}
@@ -0,0 +1,71 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"testing"
+)
+
+// TestSELDecoder tests categorizing SEL and OEM log entries.
+func TestSELDecoder(t *testing.T) {
+	decoder := NewSELDecoder()
+	decoder.AddOEMDecoder("VendorIML", func(entry *LogEntry) SELEventCategory {
+		if entry.Message == "Code 0x1234" {
+			return FanFailureSELEventCategory
+		}
+		return OtherSELEventCategory
+	})
+
+	tests := []struct {
+		name     string
+		entry    LogEntry
+		category SELEventCategory
+		asserted bool
+	}{
+		{"correctable ECC", LogEntry{EntryType: SELLogEntryType, SensorType: MemorySensorType,
+			EntryCode: AssertLogEntryCode, MessageID: "0xA0FF01"}, MemoryECCSELEventCategory, true},
+		{"uncorrectable ECC cleared", LogEntry{EntryType: SELLogEntryType, SensorType: MemorySensorType,
+			EntryCode: DeassertLogEntryCode, MessageID: "0xA1FF01"}, MemoryECCSELEventCategory, false},
+		{"memory presence", LogEntry{EntryType: SELLogEntryType, SensorType: MemorySensorType,
+			EntryCode: AssertLogEntryCode, MessageID: "0x06FFFF"}, OtherSELEventCategory, true},
+		{"memory without event data", LogEntry{EntryType: SELLogEntryType, SensorType: MemorySensorType,
+			EntryCode: AssertLogEntryCode}, OtherSELEventCategory, true},
+		{"PSU failure", LogEntry{EntryType: SELLogEntryType, SensorType: PowerSupplyConverterSensorType,
+			EntryCode: AssertLogEntryCode, MessageID: "0x01FFFF"}, PowerSupplyFailureSELEventCategory, true},
+		{"PSU presence", LogEntry{EntryType: SELLogEntryType, SensorType: PowerSupplyConverterSensorType,
+			EntryCode: AssertLogEntryCode, MessageID: "0x00FFFF"}, OtherSELEventCategory, true},
+		{"power unit failure", LogEntry{EntryType: SELLogEntryType, SensorType: PowerUnitSensorType,
+			EntryCode: AssertLogEntryCode, MessageID: "0x06FFFF"}, PowerSupplyFailureSELEventCategory, true},
+		{"fan below critical", LogEntry{EntryType: SELLogEntryType, SensorType: FanSensorType,
+			EntryCode: LowerCriticalGoingLowLogEntryCode}, FanFailureSELEventCategory, true},
+		{"fan below non-critical", LogEntry{EntryType: SELLogEntryType, SensorType: FanSensorType,
+			EntryCode: LowerNonCriticalGoingLowLogEntryCode}, OtherSELEventCategory, true},
+		{"temperature trip", LogEntry{EntryType: SELLogEntryType, SensorType: TemperatureSensorType,
+			EntryCode: UpperNonRecoverableGoingHighLogEntryCode}, ThermalTripSELEventCategory, true},
+		{"processor thermal trip", LogEntry{EntryType: SELLogEntryType, SensorType: ProcessorSensorType,
+			EntryCode: AssertLogEntryCode, MessageID: "0x01FFFF"}, ThermalTripSELEventCategory, true},
+		{"registered OEM format", LogEntry{EntryType: OemLogEntryType, OemRecordFormat: "VendorIML",
+			Message: "Code 0x1234"}, FanFailureSELEventCategory, true},
+		{"OEM message", LogEntry{EntryType: OemLogEntryType, OemRecordFormat: "Other",
+			Message: "Power Supply 2 failure detected"}, PowerSupplyFailureSELEventCategory, true},
+		{"OEM sensor message", LogEntry{EntryType: SELLogEntryType, SensorType: OEMSensorType,
+			OemSensorType: "Unknown", Message: "Uncorrectable ECC error on DIMM A1"}, MemoryECCSELEventCategory, true},
+		{"OEM unrelated message", LogEntry{EntryType: OemLogEntryType,
+			Message: "Necessary access granted"}, OtherSELEventCategory, true},
+		{"event entry", LogEntry{EntryType: EventLogEntryType, SensorType: MemorySensorType,
+			MessageID: "0x00FFFF"}, OtherSELEventCategory, true},
+	}
+
+	for _, test := range tests {
+		entry := test.entry
+		event := decoder.Decode(&entry)
+		if event.Category != test.category {
+			t.Errorf("%s: expected category %s, got %s", test.name, test.category, event.Category)
+		}
+		if event.Asserted != test.asserted {
+			t.Errorf("%s: expected asserted %t", test.name, test.asserted)
+		}
+	}
+}
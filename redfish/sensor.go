@@ -0,0 +1,255 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// ReadingType is the type of a sensor reading.
+type ReadingType string
+
+const (
+	// TemperatureReadingType The sensor measures temperature in degrees
+	// Celsius.
+	TemperatureReadingType ReadingType = "Temperature"
+	// HumidityReadingType The sensor measures relative humidity in percent.
+	HumidityReadingType ReadingType = "Humidity"
+	// PowerReadingType The sensor measures power in Watts.
+	PowerReadingType ReadingType = "Power"
+	// EnergykWhReadingType The sensor measures energy in kilowatt-hours.
+	EnergykWhReadingType ReadingType = "EnergykWh"
+	// EnergyJoulesReadingType The sensor measures energy in Joules.
+	EnergyJoulesReadingType ReadingType = "EnergyJoules"
+	// VoltageReadingType The sensor measures voltage in Volts.
+	VoltageReadingType ReadingType = "Voltage"
+	// CurrentReadingType The sensor measures current in Amperes.
+	CurrentReadingType ReadingType = "Current"
+	// FrequencyReadingType The sensor measures frequency in Hertz.
+	FrequencyReadingType ReadingType = "Frequency"
+	// PressureReadingType The sensor measures pressure in Pascals.
+	PressureReadingType ReadingType = "Pressure"
+	// LiquidLevelReadingType The sensor measures liquid level in
+	// centimeters.
+	LiquidLevelReadingType ReadingType = "LiquidLevel"
+	// RotationalReadingType The sensor measures rotational speed in
+	// revolutions per minute.
+	RotationalReadingType ReadingType = "Rotational"
+	// AirFlowReadingType The sensor measures air flow in cubic feet per
+	// minute.
+	AirFlowReadingType ReadingType = "AirFlow"
+	// LiquidFlowReadingType The sensor measures liquid flow in liters per
+	// second.
+	LiquidFlowReadingType ReadingType = "LiquidFlow"
+	// BarometricReadingType The sensor measures barometric pressure in
+	// millimeters of mercury.
+	BarometricReadingType ReadingType = "Barometric"
+	// AltitudeReadingType The sensor measures altitude in meters.
+	AltitudeReadingType ReadingType = "Altitude"
+	// PercentReadingType The sensor measures a percentage.
+	PercentReadingType ReadingType = "Percent"
+)
+
+// SensorThreshold shall contain the properties for an individual threshold
+// of a sensor.
+type SensorThreshold struct {
+	// Activation shall indicate the direction of crossing of the reading for
+	// this sensor that activates the threshold.
+	Activation ThresholdActivation
+	// DwellTime shall indicate the duration the sensor value must violate the
+	// threshold before the threshold is activated.
+	DwellTime string
+	// HysteresisReading shall indicate the offset from the reading for this
+	// sensor and the threshold value that deactivates the threshold.
+	HysteresisReading *float32
+	// Reading shall indicate the reading for this sensor that activates the
+	// threshold, nil if the threshold is not set.
+	Reading *float32
+}
+
+// SensorThresholdSet shall contain the set of thresholds of a sensor.
+type SensorThresholdSet struct {
+	// LowerCaution shall contain the value at which the reading is below
+	// normal range.
+	LowerCaution SensorThreshold
+	// LowerCritical shall contain the value at which the reading is below
+	// normal range but not yet fatal.
+	LowerCritical SensorThreshold
+	// LowerFatal shall contain the value at which the reading is below
+	// normal range and fatal.
+	LowerFatal SensorThreshold
+	// UpperCaution shall contain the value at which the reading is above
+	// normal range.
+	UpperCaution SensorThreshold
+	// UpperCritical shall contain the value at which the reading is above
+	// normal range but not yet fatal.
+	UpperCritical SensorThreshold
+	// UpperFatal shall contain the value at which the reading is above
+	// normal range and fatal.
+	UpperFatal SensorThreshold
+}
+
+// SensorThresholds gets the thresholds in the form used by the
+// ThresholdEvaluator, with caution thresholds as non-critical.
+func (thresholds SensorThresholdSet) SensorThresholds() SensorThresholds {
+	return SensorThresholds{
+		LowerNonCritical: thresholds.LowerCaution.Reading,
+		LowerCritical:    thresholds.LowerCritical.Reading,
+		LowerFatal:       thresholds.LowerFatal.Reading,
+		UpperNonCritical: thresholds.UpperCaution.Reading,
+		UpperCritical:    thresholds.UpperCritical.Reading,
+		UpperFatal:       thresholds.UpperFatal.Reading,
+	}
+}
+
+// SensorExcerpt shall contain the reading of a sensor, copied from the
+// Sensor resource it links to.
+type SensorExcerpt struct {
+	// DataSourceURI shall contain the location of the Sensor resource that
+	// provides the reading.
+	DataSourceURI string `json:"DataSourceUri"`
+	// Reading shall contain the reading of the sensor, nil if the service
+	// could not read it.
+	Reading *float32
+}
+
+// SensorFanExcerpt shall contain the speed of a fan, copied from the Sensor
+// resource it links to.
+type SensorFanExcerpt struct {
+	// DataSourceURI shall contain the location of the Sensor resource that
+	// provides the reading.
+	DataSourceURI string `json:"DataSourceUri"`
+	// Reading shall contain the speed of the fan in percent of its maximum
+	// speed.
+	Reading *float32
+	// SpeedRPM shall contain the speed of the fan in revolutions per minute.
+	SpeedRPM *float32
+}
+
+// SensorArrayExcerpt shall contain the reading of one sensor of an array of
+// sensors, copied from the Sensor resource it links to.
+type SensorArrayExcerpt struct {
+	// DataSourceURI shall contain the location of the Sensor resource that
+	// provides the reading.
+	DataSourceURI string `json:"DataSourceUri"`
+	// DeviceName shall contain the name of the device measured by the sensor.
+	DeviceName string
+	// PhysicalContext shall contain the area or device to which the reading
+	// applies.
+	PhysicalContext common.PhysicalContext
+	// PhysicalSubContext shall contain the usage or location within the
+	// PhysicalContext to which the reading applies.
+	PhysicalSubContext string
+	// Reading shall contain the reading of the sensor, nil if the service
+	// could not read it.
+	Reading *float32
+}
+
+// Sensor shall represent a sensor for a Redfish implementation.
+type Sensor struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Accuracy shall contain the percent error +/- of the measured versus
+	// actual values of the Reading property.
+	Accuracy *float32
+	// Description provides a description of this resource.
+	Description string
+	// Location shall indicate the location information for this sensor.
+	Location common.Location
+	// PeakReading shall contain the peak sensor value since the last
+	// SensorResetTime.
+	PeakReading *float32
+	// PhysicalContext shall contain a description of the affected component
+	// or region within the equipment to which this sensor measurement
+	// applies.
+	PhysicalContext common.PhysicalContext
+	// PhysicalSubContext shall contain a description of the usage or
+	// sub-region within the equipment to which this sensor measurement
+	// applies.
+	PhysicalSubContext string
+	// Precision shall contain the number of significant digits in the
+	// Reading property.
+	Precision *float32
+	// Reading shall contain the sensor value, nil if the service could not
+	// read it.
+	Reading *float32
+	// ReadingRangeMax shall indicate the maximum possible value of the
+	// Reading property for this sensor.
+	ReadingRangeMax *float32
+	// ReadingRangeMin shall indicate the minimum possible value of the
+	// Reading property for this sensor.
+	ReadingRangeMin *float32
+	// ReadingType shall contain the type of the sensor.
+	ReadingType ReadingType
+	// ReadingUnits shall contain the units of the sensor's reading and
+	// thresholds, as UCUM units such as "Cel" or "W".
+	ReadingUnits string
+	// SensingInterval shall contain the time interval between readings of
+	// the physical sensor.
+	SensingInterval string
+	// SensorResetTime shall contain the date and time when the time-based
+	// properties were last reset.
+	SensorResetTime string
+	// SpeedRPM shall contain the rotational speed of the device in
+	// revolutions per minute, for fan sensors reporting in percent.
+	SpeedRPM *float32
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// Thresholds shall contain the set of thresholds that derive a sensor's
+	// health and operational range.
+	Thresholds SensorThresholdSet
+}
+
+// GetSensor will get a Sensor instance from the service.
+func GetSensor(c common.Client, uri string) (*Sensor, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var sensor Sensor
+	err = json.NewDecoder(resp.Body).Decode(&sensor)
+	if err != nil {
+		return nil, err
+	}
+
+	sensor.SetClient(c)
+	return &sensor, nil
+}
+
+// ListReferencedSensors gets the collection of Sensor from
+// a provided reference.
+func ListReferencedSensors(c common.Client, link string) ([]*Sensor, error) {
+	var result []*Sensor
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, sensorLink := range links.ItemLinks {
+		sensor, err := GetSensor(c, sensorLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, sensor)
+	}
+
+	return result, nil
+}
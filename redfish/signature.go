@@ -0,0 +1,103 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// SignatureTypeRegistry is the registry that defines the type of a
+// signature.
+type SignatureTypeRegistry string
+
+const (
+	// UEFISignatureTypeRegistry shall indicate the signature is defined in
+	// the UEFI Specification.
+	UEFISignatureTypeRegistry SignatureTypeRegistry = "UEFI"
+)
+
+// Signature is used to represent a signature, such as a hash, in a UEFI
+// Secure Boot database.
+type Signature struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// SignatureString shall contain the string of the signature, and the
+	// format shall follow the requirements specified by the value of the
+	// SignatureType property.
+	SignatureString string
+	// SignatureType shall contain the format type for the signature, as
+	// defined by the registry in SignatureTypeRegistry.
+	SignatureType string
+	// SignatureTypeRegistry shall contain the type for the signature.
+	SignatureTypeRegistry SignatureTypeRegistry
+	// UefiSignatureOwner shall contain the GUID of the UEFI signature owner
+	// for this signature as defined by the UEFI Specification.
+	UefiSignatureOwner string
+}
+
+// GetSignature will get a Signature instance from the service.
+func GetSignature(c common.Client, uri string) (*Signature, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var signature Signature
+	err = json.NewDecoder(resp.Body).Decode(&signature)
+	if err != nil {
+		return nil, err
+	}
+
+	signature.SetClient(c)
+	return &signature, nil
+}
+
+// ListReferencedSignatures gets the collection of Signature from
+// a provided reference.
+func ListReferencedSignatures(c common.Client, link string) ([]*Signature, error) {
+	var result []*Signature
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, signatureLink := range links.ItemLinks {
+		signature, err := GetSignature(c, signatureLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, signature)
+	}
+
+	return result, nil
+}
+
+// Delete removes the signature from its database.
+func (signature *Signature) Delete() error {
+	resp, err := signature.Client.Delete(signature.ODataID)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,49 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var signatureBody = strings.NewReader(
+	`{
+		"@odata.type": "#Signature.v1_0_1.Signature",
+		"@odata.id": "/redfish/v1/Systems/1/SecureBoot/SecureBootDatabases/db/Signatures/1",
+		"Id": "1",
+		"Name": "SHA256 Signature",
+		"SignatureString": "80B4D96931BF0D02FD91A61E19D14F1DA452E66DB2408CA8604D411F92659F0A",
+		"SignatureType": "EFI_CERT_SHA256_GUID",
+		"SignatureTypeRegistry": "UEFI",
+		"UefiSignatureOwner": "28d5e212-165b-4ca0-909b-c86b9cee0112"
+	}`)
+
+// TestSignature tests the parsing of Signature objects.
+func TestSignature(t *testing.T) {
+	var result Signature
+	err := json.NewDecoder(signatureBody).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "1" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.SignatureType != "EFI_CERT_SHA256_GUID" {
+		t.Errorf("Invalid signature type: %s", result.SignatureType)
+	}
+
+	if result.SignatureTypeRegistry != UEFISignatureTypeRegistry {
+		t.Errorf("Invalid signature type registry: %s", result.SignatureTypeRegistry)
+	}
+
+	if result.UefiSignatureOwner != "28d5e212-165b-4ca0-909b-c86b9cee0112" {
+		t.Errorf("Invalid signature owner: %s", result.UefiSignatureOwner)
+	}
+}
@@ -0,0 +1,123 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// SoftwareInventory is used to represent a single software component
+// managed by this Redfish service.
+type SoftwareInventory struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// LowestSupportedVersion shall represent the lowest supported version of
+	// this software. This string is formatted using the same format used for
+	// the Version property.
+	LowestSupportedVersion string
+	// Manufacturer shall represent the name of the manufacturer or producer
+	// of this software.
+	Manufacturer string
+	// relatedItem shall contain an array of IDs for pointers consistent with
+	// JSON pointer syntax to the resource that is associated with this
+	// software inventory item.
+	relatedItem []string
+	// ReleaseDate shall contain the date of release or production for this
+	// software.
+	ReleaseDate string
+	// SoftwareID shall represent an implementation-specific label that
+	// identifies this software. This string correlates with a component
+	// repository or database.
+	SoftwareID string `json:"SoftwareId"`
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// UefiDevicePaths shall contain a list UEFI device paths of the
+	// components associated with this software inventory item.
+	UefiDevicePaths []string
+	// Updateable shall indicate whether the Update Service can update this
+	// software.
+	Updateable bool
+	// Version shall contain the version of this software.
+	Version string
+	// WriteProtected shall indicate whether the software image can be
+	// overwritten, where a value true shall indicate that the software
+	// cannot be altered or overwritten.
+	WriteProtected bool
+}
+
+// UnmarshalJSON unmarshals a SoftwareInventory object from the raw JSON.
+func (softwareinventory *SoftwareInventory) UnmarshalJSON(b []byte) error {
+	type temp SoftwareInventory
+	var t struct {
+		temp
+		RelatedItem common.Links
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*softwareinventory = SoftwareInventory(t.temp)
+
+	// Extract the links to other entities for later
+	softwareinventory.relatedItem = t.RelatedItem.ToStrings()
+
+	return nil
+}
+
+// GetSoftwareInventory will get a SoftwareInventory instance from the service.
+func GetSoftwareInventory(c common.Client, uri string) (*SoftwareInventory, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var softwareinventory SoftwareInventory
+	err = json.NewDecoder(resp.Body).Decode(&softwareinventory)
+	if err != nil {
+		return nil, err
+	}
+
+	softwareinventory.SetClient(c)
+	return &softwareinventory, nil
+}
+
+// ListReferencedSoftwareInventories gets the collection of SoftwareInventory from
+// a provided reference.
+func ListReferencedSoftwareInventories(c common.Client, link string) ([]*SoftwareInventory, error) {
+	var result []*SoftwareInventory
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, softwareinventoryLink := range links.ItemLinks {
+		softwareinventory, err := GetSoftwareInventory(c, softwareinventoryLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, softwareinventory)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,201 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// Switch shall be used to represent a simple switch for a Redfish
+// implementation.
+type Switch struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// AssetTag shall be a user-assigned value used to track the switch.
+	AssetTag string
+	// CurrentBandwidthGbps shall contain the internal bandwidth of this
+	// switch currently negotiated and running.
+	CurrentBandwidthGbps float32
+	// Description provides a description of this resource.
+	Description string
+	// DomainID shall contain The Domain ID for this switch.
+	DomainID int `json:"DomainID"`
+	// IndicatorLED shall contain the indicator light state for the indicator
+	// light associated with this switch.
+	IndicatorLED common.IndicatorLED
+	// IsManaged shall indicate whether this switch is in a managed or
+	// unmanaged state.
+	IsManaged bool
+	// Location shall contain location information of the associated switch.
+	Location common.Location
+	// Manufacturer shall contain the name of the organization responsible for
+	// producing the switch.
+	Manufacturer string
+	// MaxBandwidthGbps shall contain the maximum internal bandwidth this
+	// switch is capable of being configured.
+	MaxBandwidthGbps float32
+	// Model shall contain the manufacturer-provided model information of
+	// this switch.
+	Model string
+	// PartNumber shall contain the manufacturer-provided part number for the
+	// switch.
+	PartNumber string
+	// PowerState shall contain the power state of the switch.
+	PowerState PowerState
+	// Redundancy shall show how this switch is grouped with other switches
+	// for form redundancy sets.
+	Redundancy []Redundancy
+	// SKU shall contain the SKU number for this switch.
+	SKU string
+	// SerialNumber shall contain a manufacturer-allocated number that
+	// identifies the switch.
+	SerialNumber string
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// SupportedProtocols shall contain an array of protocols this switch can
+	// be configured to support.
+	SupportedProtocols []common.Protocol
+	// SwitchType shall contain the protocol being sent over this switch.
+	SwitchType common.Protocol
+	// TotalSwitchWidth shall contain the number of physical transport lanes,
+	// phys, or other physical transport links that this switch contains.
+	TotalSwitchWidth int
+	// chassis shall contain a link to a resource of type Chassis with which
+	// this switch is associated.
+	chassis string
+	// endpoints shall contain an array of links to resources of type
+	// Endpoint with which this switch is associated.
+	endpoints []string
+	// managedBy shall contain an array of links to resources of type Manager
+	// with which this switch is associated.
+	managedBy []string
+	// ports shall contain a link to a resource collection of type
+	// PortCollection.
+	ports string
+}
+
+// UnmarshalJSON unmarshals a Switch object from the raw JSON.
+func (s *Switch) UnmarshalJSON(b []byte) error {
+	type temp Switch
+	type links struct {
+		Chassis   common.Link
+		Endpoints common.Links
+		ManagedBy common.Links
+	}
+	var t struct {
+		temp
+		Links links
+		Ports common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*s = Switch(t.temp)
+
+	// Extract the links to other entities for later
+	s.chassis = string(t.Links.Chassis)
+	s.endpoints = t.Links.Endpoints.ToStrings()
+	s.managedBy = t.Links.ManagedBy.ToStrings()
+	s.ports = string(t.Ports)
+
+	return nil
+}
+
+// GetSwitch will get a Switch instance from the service.
+func GetSwitch(c common.Client, uri string) (*Switch, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var s Switch
+	err = json.NewDecoder(resp.Body).Decode(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	s.SetClient(c)
+	return &s, nil
+}
+
+// ListReferencedSwitches gets the collection of Switch from
+// a provided reference.
+func ListReferencedSwitches(c common.Client, link string) ([]*Switch, error) {
+	var result []*Switch
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, switchLink := range links.ItemLinks {
+		s, err := GetSwitch(c, switchLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// Chassis gets the chassis containing the switch.
+func (s *Switch) Chassis() (*Chassis, error) {
+	if s.chassis == "" {
+		return nil, nil
+	}
+
+	return GetChassis(s.Client, s.chassis)
+}
+
+// Endpoints gets the endpoints associated with the switch.
+func (s *Switch) Endpoints() ([]*Endpoint, error) {
+	var result []*Endpoint
+	for _, link := range s.endpoints {
+		endpoint, err := GetEndpoint(s.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, endpoint)
+	}
+
+	return result, nil
+}
+
+// ManagedBy gets the managers of the switch.
+func (s *Switch) ManagedBy() ([]*Manager, error) {
+	var result []*Manager
+	for _, link := range s.managedBy {
+		manager, err := GetManager(s.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, manager)
+	}
+
+	return result, nil
+}
+
+// Ports gets the ports of the switch.
+func (s *Switch) Ports() ([]*Port, error) {
+	return ListReferencedPorts(s.Client, s.ports)
+}
@@ -0,0 +1,91 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+var switchBody = strings.NewReader(
+	`{
+		"@odata.context": "/redfish/v1/$metadata#Switch.Switch",
+		"@odata.type": "#Switch.v1_3_1.Switch",
+		"@odata.id": "/redfish/v1/Fabrics/PCIe/Switches/1",
+		"Id": "1",
+		"Name": "PCIe Switch",
+		"SwitchType": "PCIe",
+		"Manufacturer": "Contoso",
+		"Model": "PCIe Switch 9000",
+		"SerialNumber": "2M220100SL",
+		"DomainID": 1,
+		"IsManaged": true,
+		"TotalSwitchWidth": 97,
+		"IndicatorLED": "Lit",
+		"PowerState": "On",
+		"Status": {
+			"State": "Enabled",
+			"Health": "OK"
+		},
+		"Ports": {
+			"@odata.id": "/redfish/v1/Fabrics/PCIe/Switches/1/Ports"
+		},
+		"Links": {
+			"Chassis": {
+				"@odata.id": "/redfish/v1/Chassis/PCIeSwitchChassis"
+			},
+			"ManagedBy": [
+				{"@odata.id": "/redfish/v1/Managers/BMC"}
+			],
+			"Endpoints": [
+				{"@odata.id": "/redfish/v1/Fabrics/PCIe/Endpoints/Host1"}
+			]
+		}
+	}`)
+
+// TestSwitch tests the parsing of Switch objects.
+func TestSwitch(t *testing.T) {
+	var result Switch
+	err := json.NewDecoder(switchBody).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "1" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.SwitchType != common.PCIeProtocol {
+		t.Errorf("Invalid switch type: %s", result.SwitchType)
+	}
+
+	if result.TotalSwitchWidth != 97 {
+		t.Errorf("Invalid total switch width: %d", result.TotalSwitchWidth)
+	}
+
+	if result.PowerState != OnPowerState {
+		t.Errorf("Invalid power state: %s", result.PowerState)
+	}
+
+	if result.ports != "/redfish/v1/Fabrics/PCIe/Switches/1/Ports" {
+		t.Errorf("Invalid ports link: %s", result.ports)
+	}
+
+	if result.chassis != "/redfish/v1/Chassis/PCIeSwitchChassis" {
+		t.Errorf("Invalid chassis link: %s", result.chassis)
+	}
+
+	if result.managedBy[0] != "/redfish/v1/Managers/BMC" {
+		t.Errorf("Invalid managed by links: %v", result.managedBy)
+	}
+
+	if result.endpoints[0] != "/redfish/v1/Fabrics/PCIe/Endpoints/Host1" {
+		t.Errorf("Invalid endpoint links: %v", result.endpoints)
+	}
+}
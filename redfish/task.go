@@ -6,6 +6,10 @@ package redfish
 
 import (
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
 
 	"github.com/rocksolidlabs/gofish/common"
 )
@@ -196,3 +200,145 @@ func ListReferencedTasks(c common.Client, link string) ([]*Task, error) {
 
 	return result, nil
 }
+
+// TaskMonitor tracks the progress of an asynchronous operation that was
+// accepted by the service.
+type TaskMonitor struct {
+	// URI is the location of the task monitor returned by the service.
+	URI string
+	// TaskURI is the location of the Task resource for the operation, if the
+	// service reported one.
+	TaskURI string
+	// Location is the location the task monitor reported for the result of
+	// the operation once it finished, if any.
+	Location string
+
+	client common.Client
+}
+
+// NewTaskMonitor creates a TaskMonitor from the response to a request that
+// started an asynchronous operation. The response body is consumed and
+// closed.
+func NewTaskMonitor(c common.Client, resp *http.Response) (*TaskMonitor, error) {
+	defer resp.Body.Close()
+
+	monitor := &TaskMonitor{
+		URI:    resp.Header.Get("Location"),
+		client: c,
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Services commonly return the Task resource along with the monitor
+	// location, but the body is optional.
+	if len(body) > 0 {
+		var t struct {
+			ODataID string `json:"@odata.id"`
+		}
+		if json.Unmarshal(body, &t) == nil {
+			monitor.TaskURI = t.ODataID
+		}
+	}
+
+	if monitor.URI == "" && monitor.TaskURI == "" {
+		return nil, fmt.Errorf("service did not return a task monitor (status %d)", resp.StatusCode)
+	}
+
+	return monitor, nil
+}
+
+// Task gets the current state of the Task tracked by this monitor.
+func (monitor *TaskMonitor) Task() (*Task, error) {
+	task, _, err := monitor.Poll()
+	return task, err
+}
+
+// Poll checks the progress of the operation once, returning the current
+// state of its Task and whether the operation has finished. The task
+// monitor answers 202 Accepted while the operation runs. Once it has
+// finished the monitor answers with the result of the operation, which is
+// often not a Task, in which case a completed Task is returned. A failed
+// operation is returned as an error by the service.
+func (monitor *TaskMonitor) Poll() (*Task, bool, error) {
+	if monitor.URI == "" {
+		task, err := GetTask(monitor.client, monitor.TaskURI)
+		if err != nil {
+			return nil, false, err
+		}
+		return task, task.Finished(), nil
+	}
+
+	resp, err := monitor.client.GetAccepted(monitor.URI)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The monitor may also be the Task resource itself, which is answered
+	// with 200 OK while the task runs.
+	var task *Task
+	if len(body) > 0 {
+		var t Task
+		if json.Unmarshal(body, &t) == nil && t.TaskState != "" {
+			task = &t
+			task.SetClient(monitor.client)
+		}
+	}
+
+	if resp.StatusCode == http.StatusAccepted || (task != nil && !task.Finished()) {
+		if task == nil {
+			task = &Task{ODataID: monitor.TaskURI, TaskState: RunningTaskState}
+		}
+		return task, false, nil
+	}
+
+	monitor.Location = resp.Header.Get("Location")
+	if task == nil {
+		task = &Task{ODataID: monitor.TaskURI, TaskState: CompletedTaskState, TaskStatus: common.OKHealth}
+	}
+
+	return task, true, nil
+}
+
+// Wait polls the task at the given interval until it has finished or the
+// timeout has passed. A timeout of zero waits indefinitely.
+func (monitor *TaskMonitor) Wait(interval, timeout time.Duration) (*Task, error) {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		task, finished, err := monitor.Poll()
+		if err != nil {
+			return nil, err
+		}
+
+		if finished {
+			return task, nil
+		}
+
+		if !deadline.IsZero() && time.Now().Add(interval).After(deadline) {
+			return task, fmt.Errorf("timed out waiting for task monitor %s", monitor.URI)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// Finished indicates whether the task has reached a state it will not leave
+// on its own.
+func (task *Task) Finished() bool {
+	switch task.TaskState {
+	case CompletedTaskState, KilledTaskState, ExceptionTaskState, CancelledTaskState:
+		return true
+	}
+	return false
+}
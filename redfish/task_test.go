@@ -6,8 +6,10 @@ package redfish
 
 import (
 	"encoding/json"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rocksolidlabs/gofish/common"
 )
@@ -65,3 +67,72 @@ func TestTask(t *testing.T) {
 		t.Errorf("Invalid TaskStatus: %s", result.TaskStatus)
 	}
 }
+
+// TestTaskMonitor tests polling a task monitor that answers 202 Accepted
+// while the operation runs and the result of the operation once it is done.
+func TestTaskMonitor(t *testing.T) {
+	monitorURI := "GET /redfish/v1/TaskService/TaskMonitors/1"
+	client := &testClient{
+		responses: map[string]string{
+			monitorURI: "",
+		},
+		statuses: map[string]int{
+			monitorURI: http.StatusAccepted,
+		},
+	}
+	monitor := &TaskMonitor{
+		URI:     "/redfish/v1/TaskService/TaskMonitors/1",
+		TaskURI: "/redfish/v1/TaskService/Tasks/1",
+		client:  client,
+	}
+
+	task, finished, err := monitor.Poll()
+	if err != nil {
+		t.Fatalf("Error polling task monitor: %s", err)
+	}
+	if finished || task.TaskState != RunningTaskState {
+		t.Errorf("Accepted task monitor should be running: %v %s", finished, task.TaskState)
+	}
+
+	_, err = monitor.Wait(time.Millisecond, 5*time.Millisecond)
+	if err == nil {
+		t.Error("Expected a timeout waiting for a running task")
+	}
+
+	client.statuses[monitorURI] = http.StatusCreated
+	client.headers = map[string]http.Header{
+		monitorURI: {"Location": {"/redfish/v1/Managers/BMC/LogServices/Dump/Entries/3"}},
+	}
+	client.responses[monitorURI] = `{"@odata.id": "/redfish/v1/Managers/BMC/LogServices/Dump/Entries/3"}`
+
+	task, err = monitor.Wait(time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatalf("Error waiting for task monitor: %s", err)
+	}
+	if task.TaskState != CompletedTaskState || task.TaskStatus != common.OKHealth {
+		t.Errorf("Finished task monitor should be completed: %s %s", task.TaskState, task.TaskStatus)
+	}
+	if monitor.Location != "/redfish/v1/Managers/BMC/LogServices/Dump/Entries/3" {
+		t.Errorf("Unexpected result location: %s", monitor.Location)
+	}
+
+	// Some services return the Task resource itself as the monitor.
+	client.statuses[monitorURI] = http.StatusOK
+	client.responses[monitorURI] = `{"@odata.id": "/redfish/v1/TaskService/Tasks/1", "Id": "1", "TaskState": "Running"}`
+	_, finished, err = monitor.Poll()
+	if err != nil {
+		t.Fatalf("Error polling task monitor: %s", err)
+	}
+	if finished {
+		t.Error("Running task should not be finished")
+	}
+
+	client.responses[monitorURI] = `{"@odata.id": "/redfish/v1/TaskService/Tasks/1", "Id": "1", "TaskState": "Exception", "TaskStatus": "Critical"}`
+	task, err = monitor.Wait(time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatalf("Error waiting for task monitor: %s", err)
+	}
+	if task.TaskState != ExceptionTaskState {
+		t.Errorf("Unexpected task state: %s", task.TaskState)
+	}
+}
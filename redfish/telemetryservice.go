@@ -0,0 +1,218 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// CollectionFunction is the function used to compute a metric over its
+// collection duration.
+type CollectionFunction string
+
+const (
+	// AverageCollectionFunction An averaging function.
+	AverageCollectionFunction CollectionFunction = "Average"
+	// MaximumCollectionFunction A maximum function.
+	MaximumCollectionFunction CollectionFunction = "Maximum"
+	// MinimumCollectionFunction A minimum function.
+	MinimumCollectionFunction CollectionFunction = "Minimum"
+	// SummationCollectionFunction A summation function.
+	SummationCollectionFunction CollectionFunction = "Summation"
+)
+
+// Wildcard shall contain a wildcard and its substitution values.
+type Wildcard struct {
+	// Name shall contain the string used as a wildcard.
+	Name string
+	// Values shall contain the list of values to substitute for the
+	// wildcard.
+	Values []string
+}
+
+// TelemetryService is used to represent the metrics, reports and triggers
+// offered by the Redfish service.
+type TelemetryService struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// logService shall contain a link to a resource of type LogService that
+	// this telemetry service uses.
+	logService string
+	// MaxReports shall contain the maximum number of metric reports that
+	// this service supports.
+	MaxReports int
+	// metricDefinitions shall contain a link to a resource collection of
+	// type MetricDefinitionCollection.
+	metricDefinitions string
+	// metricReportDefinitions shall contain a link to a resource collection
+	// of type MetricReportDefinitionCollection.
+	metricReportDefinitions string
+	// metricReports shall contain a link to a resource collection of type
+	// MetricReportCollection.
+	metricReports string
+	// MinCollectionInterval shall contain the minimum time interval between
+	// gathering metric data that this service allows.
+	MinCollectionInterval string
+	// ServiceEnabled shall indicate whether this service is enabled.
+	ServiceEnabled bool
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// SupportedCollectionFunctions shall contain the function to apply over
+	// the collection duration.
+	SupportedCollectionFunctions []CollectionFunction
+	// triggers shall contain a link to a resource collection of type
+	// TriggersCollection.
+	triggers string
+}
+
+// UnmarshalJSON unmarshals a TelemetryService object from the raw JSON.
+func (telemetryservice *TelemetryService) UnmarshalJSON(b []byte) error {
+	type temp TelemetryService
+	var t struct {
+		temp
+		LogService              common.Link
+		MetricDefinitions       common.Link
+		MetricReportDefinitions common.Link
+		MetricReports           common.Link
+		Triggers                common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*telemetryservice = TelemetryService(t.temp)
+
+	// Extract the links to other entities for later
+	telemetryservice.logService = string(t.LogService)
+	telemetryservice.metricDefinitions = string(t.MetricDefinitions)
+	telemetryservice.metricReportDefinitions = string(t.MetricReportDefinitions)
+	telemetryservice.metricReports = string(t.MetricReports)
+	telemetryservice.triggers = string(t.Triggers)
+
+	return nil
+}
+
+// GetTelemetryService will get a TelemetryService instance from the service.
+func GetTelemetryService(c common.Client, uri string) (*TelemetryService, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var telemetryservice TelemetryService
+	err = json.NewDecoder(resp.Body).Decode(&telemetryservice)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetryservice.SetClient(c)
+	return &telemetryservice, nil
+}
+
+// LogService gets the log service used by the telemetry service.
+func (telemetryservice *TelemetryService) LogService() (*LogService, error) {
+	if telemetryservice.logService == "" {
+		return nil, nil
+	}
+
+	return GetLogService(telemetryservice.Client, telemetryservice.logService)
+}
+
+// MetricDefinitions gets the metric definitions of the service.
+func (telemetryservice *TelemetryService) MetricDefinitions() ([]*MetricDefinition, error) {
+	return ListReferencedMetricDefinitions(telemetryservice.Client, telemetryservice.metricDefinitions)
+}
+
+// MetricReportDefinitions gets the metric report definitions of the service.
+func (telemetryservice *TelemetryService) MetricReportDefinitions() ([]*MetricReportDefinition, error) {
+	return ListReferencedMetricReportDefinitions(telemetryservice.Client, telemetryservice.metricReportDefinitions)
+}
+
+// MetricReports gets the metric reports of the service.
+func (telemetryservice *TelemetryService) MetricReports() ([]*MetricReport, error) {
+	return ListReferencedMetricReports(telemetryservice.Client, telemetryservice.metricReports)
+}
+
+// Triggers gets the triggers of the service.
+func (telemetryservice *TelemetryService) Triggers() ([]*Triggers, error) {
+	return ListReferencedTriggers(telemetryservice.Client, telemetryservice.triggers)
+}
+
+// CreateMetricReportDefinition creates a new metric report definition. Only
+// the writable properties of the definition are sent to the service.
+func (telemetryservice *TelemetryService) CreateMetricReportDefinition(definition *MetricReportDefinition) (*MetricReportDefinition, error) {
+	if telemetryservice.metricReportDefinitions == "" {
+		return nil, fmt.Errorf("telemetry service does not support metric report definitions")
+	}
+
+	t := struct {
+		ID                            string   `json:"Id,omitempty"`
+		Name                          string   `json:",omitempty"`
+		AppendLimit                   int      `json:",omitempty"`
+		MetricProperties              []string `json:",omitempty"`
+		MetricReportDefinitionEnabled bool
+		MetricReportDefinitionType    MetricReportDefinitionType `json:",omitempty"`
+		MetricReportHeartbeatInterval string                     `json:",omitempty"`
+		Metrics                       []Metric                   `json:",omitempty"`
+		ReportActions                 []ReportActionsEnum        `json:",omitempty"`
+		ReportTimespan                string                     `json:",omitempty"`
+		ReportUpdates                 ReportUpdatesEnum          `json:",omitempty"`
+		Schedule                      *common.Schedule           `json:",omitempty"`
+		SuppressRepeatedMetricValue   bool                       `json:",omitempty"`
+		Wildcards                     []Wildcard                 `json:",omitempty"`
+	}{
+		ID:                            definition.ID,
+		Name:                          definition.Name,
+		AppendLimit:                   definition.AppendLimit,
+		MetricProperties:              definition.MetricProperties,
+		MetricReportDefinitionEnabled: definition.MetricReportDefinitionEnabled,
+		MetricReportDefinitionType:    definition.MetricReportDefinitionType,
+		MetricReportHeartbeatInterval: definition.MetricReportHeartbeatInterval,
+		Metrics:                       definition.Metrics,
+		ReportActions:                 definition.ReportActions,
+		ReportTimespan:                definition.ReportTimespan,
+		ReportUpdates:                 definition.ReportUpdates,
+		SuppressRepeatedMetricValue:   definition.SuppressRepeatedMetricValue,
+		Wildcards:                     definition.Wildcards,
+	}
+	if definition.MetricReportDefinitionType == PeriodicMetricReportDefinitionType {
+		t.Schedule = &definition.Schedule
+	}
+
+	resp, err := telemetryservice.Post(telemetryservice.metricReportDefinitions, t)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if location := resp.Header.Get("Location"); location != "" {
+		return GetMetricReportDefinition(telemetryservice.Client, location)
+	}
+
+	var created MetricReportDefinition
+	err = json.NewDecoder(resp.Body).Decode(&created)
+	if err != nil {
+		return nil, err
+	}
+
+	created.SetClient(telemetryservice.Client)
+	return &created, nil
+}
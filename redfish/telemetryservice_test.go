@@ -0,0 +1,160 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+var telemetryServiceBody = `{
+		"@odata.context": "/redfish/v1/$metadata#TelemetryService.TelemetryService",
+		"@odata.type": "#TelemetryService.v1_1_2.TelemetryService",
+		"@odata.id": "/redfish/v1/TelemetryService",
+		"Id": "TelemetryService",
+		"Name": "Telemetry Service",
+		"Status": {
+			"State": "Enabled",
+			"Health": "OK"
+		},
+		"ServiceEnabled": true,
+		"MaxReports": 10,
+		"MinCollectionInterval": "PT5S",
+		"SupportedCollectionFunctions": [
+			"Average",
+			"Minimum",
+			"Maximum"
+		],
+		"LogService": {
+			"@odata.id": "/redfish/v1/Managers/BMC/LogServices/Telemetry"
+		},
+		"MetricDefinitions": {
+			"@odata.id": "/redfish/v1/TelemetryService/MetricDefinitions"
+		},
+		"MetricReportDefinitions": {
+			"@odata.id": "/redfish/v1/TelemetryService/MetricReportDefinitions"
+		},
+		"MetricReports": {
+			"@odata.id": "/redfish/v1/TelemetryService/MetricReports"
+		},
+		"Triggers": {
+			"@odata.id": "/redfish/v1/TelemetryService/Triggers"
+		}
+	}`
+
+// TestTelemetryService tests the parsing of TelemetryService objects.
+func TestTelemetryService(t *testing.T) {
+	var result TelemetryService
+	err := json.NewDecoder(strings.NewReader(telemetryServiceBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "TelemetryService" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.MaxReports != 10 {
+		t.Errorf("Invalid max reports: %d", result.MaxReports)
+	}
+
+	if result.SupportedCollectionFunctions[2] != MaximumCollectionFunction {
+		t.Errorf("Invalid collection function: %s", result.SupportedCollectionFunctions[2])
+	}
+
+	if result.logService != "/redfish/v1/Managers/BMC/LogServices/Telemetry" {
+		t.Errorf("Invalid log service link: %s", result.logService)
+	}
+
+	if result.metricDefinitions != "/redfish/v1/TelemetryService/MetricDefinitions" {
+		t.Errorf("Invalid metric definitions link: %s", result.metricDefinitions)
+	}
+
+	if result.metricReportDefinitions != "/redfish/v1/TelemetryService/MetricReportDefinitions" {
+		t.Errorf("Invalid metric report definitions link: %s", result.metricReportDefinitions)
+	}
+
+	if result.metricReports != "/redfish/v1/TelemetryService/MetricReports" {
+		t.Errorf("Invalid metric reports link: %s", result.metricReports)
+	}
+
+	if result.triggers != "/redfish/v1/TelemetryService/Triggers" {
+		t.Errorf("Invalid triggers link: %s", result.triggers)
+	}
+}
+
+// TestTelemetryServiceCreateMetricReportDefinition tests creating and
+// deleting a metric report definition.
+func TestTelemetryServiceCreateMetricReportDefinition(t *testing.T) {
+	var result TelemetryService
+	err := json.NewDecoder(strings.NewReader(telemetryServiceBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{
+		responses: map[string]string{
+			"GET /redfish/v1/TelemetryService/MetricReportDefinitions/PowerMetrics": metricReportDefinitionBody,
+		},
+		headers: map[string]http.Header{
+			"POST /redfish/v1/TelemetryService/MetricReportDefinitions": {
+				"Location": []string{"/redfish/v1/TelemetryService/MetricReportDefinitions/PowerMetrics"},
+			},
+		},
+	}
+	result.SetClient(client)
+
+	definition, err := result.CreateMetricReportDefinition(&MetricReportDefinition{
+		Entity:                        common.Entity{ID: "PowerMetrics"},
+		MetricReportDefinitionType:    PeriodicMetricReportDefinitionType,
+		MetricReportDefinitionEnabled: true,
+		ReportActions:                 []ReportActionsEnum{LogToMetricReportsCollectionReportActionsEnum},
+		ReportUpdates:                 OverwriteReportUpdatesEnum,
+		Schedule:                      common.Schedule{RecurrenceInterval: "PT1M"},
+		Metrics: []Metric{
+			{
+				MetricID:           "AverageConsumedWatts",
+				CollectionFunction: AverageCollectionFunction,
+				CollectionDuration: "PT1M",
+				MetricProperties:   []string{"/redfish/v1/Chassis/1/Power#/PowerControl/0/PowerConsumedWatts"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating metric report definition: %s", err)
+	}
+
+	if definition.ID != "PowerMetrics" {
+		t.Errorf("Invalid created definition: %s", definition.ID)
+	}
+
+	payload := client.calls[0].Payload
+	if client.calls[0].URL != "/redfish/v1/TelemetryService/MetricReportDefinitions" {
+		t.Errorf("Unexpected create URL: %s", client.calls[0].URL)
+	}
+	if !strings.Contains(payload, `"Id":"PowerMetrics"`) ||
+		!strings.Contains(payload, `"MetricId":"AverageConsumedWatts"`) ||
+		!strings.Contains(payload, `"Schedule":{"RecurrenceInterval":"PT1M"}`) {
+		t.Errorf("Unexpected create payload: %s", payload)
+	}
+	if strings.Contains(payload, "@odata") || strings.Contains(payload, "Status") {
+		t.Errorf("Read-only properties should not be sent: %s", payload)
+	}
+
+	err = definition.Delete()
+	if err != nil {
+		t.Errorf("Error deleting metric report definition: %s", err)
+	}
+
+	call := client.calls[len(client.calls)-1]
+	if call.Method != http.MethodDelete || call.URL != "/redfish/v1/TelemetryService/MetricReportDefinitions/PowerMetrics" {
+		t.Errorf("Unexpected delete call: %s %s", call.Method, call.URL)
+	}
+}
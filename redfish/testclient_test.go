@@ -0,0 +1,106 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// testCall records a request made through the testClient.
+type testCall struct {
+	Method      string
+	URL         string
+	ContentType string
+	Payload     string
+	IfMatch     string
+}
+
+// testClient is a common.Client that records requests and replies with
+// canned responses keyed by method and URL.
+type testClient struct {
+	calls     []testCall
+	responses map[string]string
+	headers   map[string]http.Header
+	statuses  map[string]int
+}
+
+func (c *testClient) respond(method, url string, payload string) (*http.Response, error) {
+	c.calls = append(c.calls, testCall{Method: method, URL: url, Payload: payload})
+
+	key := method + " " + url
+	body, ok := c.responses[key]
+	if !ok && method != http.MethodGet {
+		body, ok = "", true
+	}
+	if !ok {
+		return nil, fmt.Errorf("404: no response for %s", key)
+	}
+
+	header := c.headers[key]
+	if header == nil {
+		header = http.Header{}
+	}
+
+	status := c.statuses[key]
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func (c *testClient) Get(url string) (*http.Response, error) {
+	return c.respond(http.MethodGet, url, "")
+}
+
+func (c *testClient) GetAccepted(url string) (*http.Response, error) {
+	return c.respond(http.MethodGet, url, "")
+}
+
+func (c *testClient) GetRaw(url string) (*http.Response, error) {
+	return c.respond(http.MethodGet, url, "")
+}
+
+func (c *testClient) Post(url string, payload []byte) (*http.Response, error) {
+	return c.respond(http.MethodPost, url, string(payload))
+}
+
+func (c *testClient) PostStream(url string, contentType string, body io.Reader, size int64) (*http.Response, error) {
+	var buf bytes.Buffer
+	_, err := io.Copy(&buf, body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.respond(http.MethodPost, url, buf.String())
+	c.calls[len(c.calls)-1].ContentType = contentType
+	return resp, err
+}
+
+func (c *testClient) Patch(url string, payload []byte) (*http.Response, error) {
+	return c.respond(http.MethodPatch, url, string(payload))
+}
+
+func (c *testClient) PatchIfMatch(url string, payload []byte, etag string) (*http.Response, error) {
+	resp, err := c.respond(http.MethodPatch, url, string(payload))
+	c.calls[len(c.calls)-1].IfMatch = etag
+	return resp, err
+}
+
+func (c *testClient) Put(url string, payload []byte) (*http.Response, error) {
+	return c.respond(http.MethodPut, url, string(payload))
+}
+
+func (c *testClient) Delete(url string) (*http.Response, error) {
+	return c.respond(http.MethodDelete, url, "")
+}
@@ -47,13 +47,13 @@ type Fan struct {
 	// LowerThresholdCritical shall indicate the Reading is below the normal
 	// range but is not yet fatal. The units shall be the same units as the
 	// related Reading property.
-	LowerThresholdCritical float32
+	LowerThresholdCritical *float32
 	// LowerThresholdFatal shall indicate the Reading is below the normal range
 	// and is fatal. The units shall be the same units as the related Reading property.
-	LowerThresholdFatal float32
+	LowerThresholdFatal *float32
 	// LowerThresholdNonCritical shall indicate the Reading is below the normal
 	// range but is not critical. The units shall be the same units as the related Reading property.
-	LowerThresholdNonCritical float32
+	LowerThresholdNonCritical *float32
 	// Manufacturer shall be the name of the organization responsible for producing
 	// the fan. This organization might be the entity from whom the fan is
 	// purchased, but this is not necessarily true.
@@ -61,7 +61,7 @@ type Fan struct {
 	// MaxReadingRange shall indicate the
 	// highest possible value for Reading. The units shall be the same units
 	// as the related Reading property.
-	MaxReadingRange float32
+	MaxReadingRange *float32
 	// MemberID shall uniquely identify the member within the collection. For
 	// services supporting Redfish v1.6 or higher, this value shall be the
 	// zero-based array index.
@@ -69,7 +69,7 @@ type Fan struct {
 	// MinReadingRange shall indicate the
 	// lowest possible value for Reading. The units shall be the same units
 	// as the related Reading property.
-	MinReadingRange float32
+	MinReadingRange *float32
 	// Model shall contain the model information as defined by the manufacturer
 	// for the associated fan.
 	Model string
@@ -80,7 +80,7 @@ type Fan struct {
 	// within the chassis to which this fan is associated.
 	PhysicalContext string
 	// Reading shall be the current value of the fan sensor's reading.
-	Reading float32
+	Reading *float32
 	// ReadingUnits shall be the units in which the fan's reading and thresholds are measured.
 	ReadingUnits ReadingUnits
 	// Redundancy is used to show redundancy for fans and other elements in
@@ -103,14 +103,14 @@ type Fan struct {
 	// UpperThresholdCritical shall indicate the Reading is above the normal
 	// range but is not yet fatal. The units shall be the same units as the
 	// related Reading property.
-	UpperThresholdCritical float32
+	UpperThresholdCritical *float32
 	// UpperThresholdFatal shall indicate the Reading is above the normal range
 	// and is fatal. The units shall be the same units as the related Reading property.
-	UpperThresholdFatal float32
+	UpperThresholdFatal *float32
 	// UpperThresholdNonCritical shall indicate the Reading is above the normal
 	// range but is not critical. The units shall be the same units as the
 	// related Reading property.
-	UpperThresholdNonCritical float32
+	UpperThresholdNonCritical *float32
 }
 
 // UnmarshalJSON unmarshals a Fan object from the raw JSON.
@@ -138,6 +138,25 @@ func (fan *Fan) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Speed gets the reading of the fan, in its ReadingUnits, and whether the
+// service reported it.
+func (fan *Fan) Speed() (float32, bool) {
+	return common.Float32Value(fan.Reading)
+}
+
+// thresholds gets the thresholds of the fan in the form used by the
+// ThresholdEvaluator.
+func (fan *Fan) thresholds() SensorThresholds {
+	return SensorThresholds{
+		LowerNonCritical: fan.LowerThresholdNonCritical,
+		LowerCritical:    fan.LowerThresholdCritical,
+		LowerFatal:       fan.LowerThresholdFatal,
+		UpperNonCritical: fan.UpperThresholdNonCritical,
+		UpperCritical:    fan.UpperThresholdCritical,
+		UpperFatal:       fan.UpperThresholdFatal,
+	}
+}
+
 // TODO: Decide if it's worth adding a Client object to this non-Entity object.
 // // Assembly gets the assembly object for this fan.
 // func (fan *Fan) Assembly() (*Assembly, error) {
@@ -171,42 +190,42 @@ type Temperature struct {
 	// standards body, manufacturer, or a combination, and adjusted based on
 	// environmental conditions present. For example, liquid inlet
 	// temperature may be adjusted based on the available liquid pressure.
-	AdjustedMaxAllowableOperatingValue float32
+	AdjustedMaxAllowableOperatingValue *float32
 	// AdjustedMinAllowableOperatingValue shall
 	// indicate the adjusted minimum allowable operating temperature for the
 	// equipment monitored by this temperature sensor, as specified by a
 	// standards body, manufacturer, or a combination, and adjusted based on
 	// environmental conditions present. For example, liquid inlet
 	// temperature may be adjusted based on the available liquid pressure.
-	AdjustedMinAllowableOperatingValue float32
+	AdjustedMinAllowableOperatingValue *float32
 	// DeltaPhysicalContext shall be a description of the affected device or
 	// region within the chassis to which the DeltaReadingCelsius temperature
 	// measurement applies, relative to PhysicalContext.
 	DeltaPhysicalContext string
 	// DeltaReadingCelsius shall be the delta of the values of the temperature
 	// readings across this sensor and the sensor at DeltaPhysicalContext.
-	DeltaReadingCelsius float32
+	DeltaReadingCelsius *float32
 	// LowerThresholdCritical shall indicate
 	// the ReadingCelsius is below the normal range but is not yet fatal. The
 	// units shall be the same units as the related ReadingCelsius property.
-	LowerThresholdCritical float32
+	LowerThresholdCritical *float32
 	// LowerThresholdFatal shall indicate the
 	// ReadingCelsius is below the normal range and is fatal. The units shall
 	// be the same units as the related ReadingCelsius property.
-	LowerThresholdFatal float32
+	LowerThresholdFatal *float32
 	// LowerThresholdNonCritical shall indicate
 	// the ReadingCelsius is below the normal range but is not critical. The
 	// units shall be the same units as the related ReadingCelsius property.
-	LowerThresholdNonCritical float32
+	LowerThresholdNonCritical *float32
 	// MaxAllowableOperatingValue shall
 	// indicate the maximum allowable operating temperature for the equipment
 	// monitored by this temperature sensor, as specified by a standards
 	// body, manufacturer, or a combination.
-	MaxAllowableOperatingValue float32
+	MaxAllowableOperatingValue *float32
 	// MaxReadingRangeTemp shall indicate the
 	// highest possible value for ReadingCelsius. The units shall be the same
 	// units as the related ReadingCelsius property.
-	MaxReadingRangeTemp float32
+	MaxReadingRangeTemp *float32
 	// MemberID shall uniquely identify the member within the collection. For
 	// services supporting Redfish v1.6 or higher, this value shall be the
 	// zero-based array index.
@@ -214,16 +233,16 @@ type Temperature struct {
 	// MinAllowableOperatingValue shall indicate the minimum allowable operating
 	// temperature for the equipment monitored by this temperature sensor, as
 	// specified by a standards body, manufacturer, or a combination.
-	MinAllowableOperatingValue float32
+	MinAllowableOperatingValue *float32
 	// MinReadingRangeTemp shall indicate the lowest possible value for
 	// ReadingCelsius. The units shall be the same units as the related
 	// ReadingCelsius property.
-	MinReadingRangeTemp float32
+	MinReadingRangeTemp *float32
 	// PhysicalContext shall be a description of the affected device or region
 	// within the chassis to which this temperature measurement applies.
 	PhysicalContext string
 	// ReadingCelsius shall be the current value of the temperature sensor's reading.
-	ReadingCelsius float32
+	ReadingCelsius *float32
 	// SensorNumber shall be a numerical identifier for this temperature sensor
 	// that is unique within this resource.
 	SensorNumber int
@@ -232,19 +251,40 @@ type Temperature struct {
 	// UpperThresholdCritical shall indicate
 	// the ReadingCelsius is above the normal range but is not yet fatal. The
 	// units shall be the same units as the related ReadingCelsius property.
-	UpperThresholdCritical float32
+	UpperThresholdCritical *float32
 	// UpperThresholdFatal shall indicate the
 	// ReadingCelsius is above the normal range and is fatal. The units shall
 	// be the same units as the related ReadingCelsius property.
-	UpperThresholdFatal float32
+	UpperThresholdFatal *float32
 	// UpperThresholdNonCritical shall indicate
 	// the ReadingCelsius is above the normal range but is not critical. The
 	// units shall be the same units as the related ReadingCelsius property.
-	UpperThresholdNonCritical float32
+	UpperThresholdNonCritical *float32
+}
+
+// Celsius gets the temperature in degrees Celsius, and whether the service
+// reported it.
+func (temperature *Temperature) Celsius() (float32, bool) {
+	return common.Float32Value(temperature.ReadingCelsius)
+}
+
+// thresholds gets the thresholds of the temperature sensor in the form used by the
+// ThresholdEvaluator.
+func (temperature *Temperature) thresholds() SensorThresholds {
+	return SensorThresholds{
+		LowerNonCritical: temperature.LowerThresholdNonCritical,
+		LowerCritical:    temperature.LowerThresholdCritical,
+		LowerFatal:       temperature.LowerThresholdFatal,
+		UpperNonCritical: temperature.UpperThresholdNonCritical,
+		UpperCritical:    temperature.UpperThresholdCritical,
+		UpperFatal:       temperature.UpperThresholdFatal,
+	}
 }
 
 // Thermal is used to represent a thermal metrics resource for a Redfish
-// implementation.
+// implementation. Readings and thresholds are nil if the service does not
+// report them. Use common.Float32Value or common.Float32Or to read them, or
+// accessors such as Temperature.Celsius for the main readings.
 type Thermal struct {
 	common.Entity
 
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
 )
 
 var thermalBody = strings.NewReader(
@@ -113,4 +115,53 @@ func TestThermal(t *testing.T) {
 	if result.Fans[0].Name != "Fan One" {
 		t.Errorf("Invalid fan name: %s", result.Fans[0].Name)
 	}
+
+	if reading, ok := result.Fans[0].Speed(); !ok || reading != 1000 {
+		t.Errorf("Invalid fan reading: %f", reading)
+	}
+
+	if reading, ok := result.Temperatures[0].Celsius(); !ok || reading != 32 {
+		t.Errorf("Invalid temperature reading: %f", reading)
+	}
+
+	if reading, ok := common.Float32Value(result.Temperatures[0].LowerThresholdFatal); !ok || reading != 0 {
+		t.Errorf("A zero threshold should be reported: %f", reading)
+	}
+
+	if result.Temperatures[0].UpperThresholdFatal != nil {
+		t.Errorf("An absent threshold should not be reported: %f", *result.Temperatures[0].UpperThresholdFatal)
+	}
+}
+
+// TestThermalNullReadings tests that null readings are not reported as zero.
+func TestThermalNullReadings(t *testing.T) {
+	var result Thermal
+	err := json.NewDecoder(strings.NewReader(`{
+		"Id": "Thermal",
+		"Fans": [{"MemberId": "0", "Reading": null, "LowerThresholdCritical": null}],
+		"Temperatures": [{"MemberId": "0", "ReadingCelsius": null, "UpperThresholdCritical": 90}]
+	}`)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if _, ok := common.Float32Value(result.Fans[0].Reading); ok {
+		t.Error("Null fan reading should not be reported")
+	}
+
+	if result.Fans[0].LowerThresholdCritical != nil {
+		t.Error("Null fan threshold should not be reported")
+	}
+
+	if _, ok := result.Temperatures[0].Celsius(); ok {
+		t.Error("Null temperature reading should not be reported")
+	}
+
+	if common.Float32Or(result.Temperatures[0].ReadingCelsius, -1) != -1 {
+		t.Error("Null temperature reading should use the fallback")
+	}
+
+	if *result.Temperatures[0].UpperThresholdCritical != 90 {
+		t.Errorf("Invalid upper critical threshold: %f", *result.Temperatures[0].UpperThresholdCritical)
+	}
 }
@@ -0,0 +1,236 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// ThermalSubsystem shall represent the thermal subsystem of a chassis. It
+// replaces the deprecated Thermal resource.
+type ThermalSubsystem struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// fans is the link to the collection of fans.
+	fans string
+	// thermalMetrics is the link to the thermal metrics.
+	thermalMetrics string
+}
+
+// UnmarshalJSON unmarshals a ThermalSubsystem object from the raw JSON.
+func (thermalsubsystem *ThermalSubsystem) UnmarshalJSON(b []byte) error {
+	type temp ThermalSubsystem
+	var t struct {
+		temp
+		Fans           common.Link
+		ThermalMetrics common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*thermalsubsystem = ThermalSubsystem(t.temp)
+
+	// Extract the links to other entities for later
+	thermalsubsystem.fans = string(t.Fans)
+	thermalsubsystem.thermalMetrics = string(t.ThermalMetrics)
+
+	return nil
+}
+
+// GetThermalSubsystem will get a ThermalSubsystem instance from the service.
+func GetThermalSubsystem(c common.Client, uri string) (*ThermalSubsystem, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var thermalsubsystem ThermalSubsystem
+	err = json.NewDecoder(resp.Body).Decode(&thermalsubsystem)
+	if err != nil {
+		return nil, err
+	}
+
+	thermalsubsystem.SetClient(c)
+	return &thermalsubsystem, nil
+}
+
+// Fans gets the fans of this subsystem.
+func (thermalsubsystem *ThermalSubsystem) Fans() ([]*FanUnit, error) {
+	return ListReferencedFanUnits(thermalsubsystem.Client, thermalsubsystem.fans)
+}
+
+// ThermalMetrics gets the temperature readings of this subsystem.
+func (thermalsubsystem *ThermalSubsystem) ThermalMetrics() (*ThermalMetrics, error) {
+	if thermalsubsystem.thermalMetrics == "" {
+		return nil, nil
+	}
+	return GetThermalMetrics(thermalsubsystem.Client, thermalsubsystem.thermalMetrics)
+}
+
+// FanUnit shall represent a fan of a ThermalSubsystem. It replaces the Fan
+// objects of the deprecated Thermal resource.
+type FanUnit struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// HotPluggable shall indicate whether the device can be inserted or
+	// removed while the underlying equipment otherwise remains in its
+	// current operational state.
+	HotPluggable bool
+	// Location shall contain the location information of the fan.
+	Location common.Location
+	// LocationIndicatorActive shall contain the state of the indicator used
+	// to physically identify or locate this resource.
+	LocationIndicatorActive bool
+	// Manufacturer shall contain the name of the organization responsible
+	// for producing the fan.
+	Manufacturer string
+	// Model shall contain the model information as defined by the
+	// manufacturer for this fan.
+	Model string
+	// PartNumber shall contain the part number as defined by the
+	// manufacturer for this fan.
+	PartNumber string
+	// PhysicalContext shall contain a description of the affected device or
+	// region within the chassis with which this fan is associated.
+	PhysicalContext common.PhysicalContext
+	// Replaceable shall indicate whether this component can be independently
+	// replaced.
+	Replaceable bool
+	// SerialNumber shall contain the serial number as defined by the
+	// manufacturer for this fan.
+	SerialNumber string
+	// SparePartNumber shall contain the spare or replacement part number as
+	// defined by the manufacturer for this fan.
+	SparePartNumber string
+	// SpeedPercent shall contain the speed of the fan, in percent of its
+	// maximum speed and in revolutions per minute.
+	SpeedPercent SensorFanExcerpt
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+}
+
+// GetFanUnit will get a FanUnit instance from the service.
+func GetFanUnit(c common.Client, uri string) (*FanUnit, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var fanunit FanUnit
+	err = json.NewDecoder(resp.Body).Decode(&fanunit)
+	if err != nil {
+		return nil, err
+	}
+
+	fanunit.SetClient(c)
+	return &fanunit, nil
+}
+
+// ListReferencedFanUnits gets the collection of FanUnit from
+// a provided reference.
+func ListReferencedFanUnits(c common.Client, link string) ([]*FanUnit, error) {
+	var result []*FanUnit
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, fanunitLink := range links.ItemLinks {
+		fanunit, err := GetFanUnit(c, fanunitLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, fanunit)
+	}
+
+	return result, nil
+}
+
+// TemperatureSummary shall contain the temperatures of the main areas of a
+// chassis.
+type TemperatureSummary struct {
+	// Ambient shall contain the ambient temperature of the chassis.
+	Ambient SensorExcerpt
+	// Exhaust shall contain the exhaust temperature of the chassis.
+	Exhaust SensorExcerpt
+	// Intake shall contain the intake temperature of the chassis.
+	Intake SensorExcerpt
+	// Internal shall contain the internal temperature of the chassis.
+	Internal SensorExcerpt
+}
+
+// ThermalMetrics shall represent the temperature readings of a
+// ThermalSubsystem.
+type ThermalMetrics struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// TemperatureReadingsCelsius shall contain the temperatures, in degrees
+	// Celsius, of the sensors of the chassis.
+	TemperatureReadingsCelsius []SensorArrayExcerpt
+	// TemperatureSummaryCelsius shall contain the temperatures, in degrees
+	// Celsius, of the main areas of the chassis.
+	TemperatureSummaryCelsius TemperatureSummary
+}
+
+// GetThermalMetrics will get a ThermalMetrics instance from the service.
+func GetThermalMetrics(c common.Client, uri string) (*ThermalMetrics, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var thermalmetrics ThermalMetrics
+	err = json.NewDecoder(resp.Body).Decode(&thermalmetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	thermalmetrics.SetClient(c)
+	return &thermalmetrics, nil
+}
@@ -0,0 +1,368 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// ThresholdState is the classification of a sensor reading against its
+// thresholds.
+type ThresholdState string
+
+const (
+	// UnknownThresholdState The sensor did not report a reading.
+	UnknownThresholdState ThresholdState = "Unknown"
+	// NormalThresholdState The reading is within the normal range.
+	NormalThresholdState ThresholdState = "Normal"
+	// NonCriticalThresholdState The reading is outside the normal range but
+	// not critical.
+	NonCriticalThresholdState ThresholdState = "NonCritical"
+	// CriticalThresholdState The reading is outside the normal range but not
+	// yet fatal.
+	CriticalThresholdState ThresholdState = "Critical"
+	// FatalThresholdState The reading is outside the normal range and fatal.
+	FatalThresholdState ThresholdState = "Fatal"
+)
+
+// severity orders the states, with unknown below normal.
+func (state ThresholdState) severity() int {
+	switch state {
+	case NormalThresholdState:
+		return 1
+	case NonCriticalThresholdState:
+		return 2
+	case CriticalThresholdState:
+		return 3
+	case FatalThresholdState:
+		return 4
+	}
+	return 0
+}
+
+// ThresholdBound is the side of the normal range a threshold limits.
+type ThresholdBound string
+
+const (
+	// LowerThresholdBound The threshold limits readings going low.
+	LowerThresholdBound ThresholdBound = "Lower"
+	// UpperThresholdBound The threshold limits readings going high.
+	UpperThresholdBound ThresholdBound = "Upper"
+)
+
+// SensorKind is the kind of sensor that was evaluated.
+type SensorKind string
+
+const (
+	// TemperatureSensorKind A Temperature of a Thermal resource.
+	TemperatureSensorKind SensorKind = "Temperature"
+	// FanSensorKind A Fan of a Thermal resource.
+	FanSensorKind SensorKind = "Fan"
+	// VoltageSensorKind A Voltage of a Power resource.
+	VoltageSensorKind SensorKind = "Voltage"
+)
+
+// SensorThresholds are the thresholds of a sensor. Thresholds that are nil
+// are not checked.
+type SensorThresholds struct {
+	LowerNonCritical *float32
+	LowerCritical    *float32
+	LowerFatal       *float32
+	UpperNonCritical *float32
+	UpperCritical    *float32
+	UpperFatal       *float32
+}
+
+// classify gets the state of the reading, with the bound and value of the
+// threshold it crossed.
+func (thresholds SensorThresholds) classify(reading float32) (ThresholdState, ThresholdBound, *float32) {
+	checks := []struct {
+		state ThresholdState
+		lower *float32
+		upper *float32
+	}{
+		{FatalThresholdState, thresholds.LowerFatal, thresholds.UpperFatal},
+		{CriticalThresholdState, thresholds.LowerCritical, thresholds.UpperCritical},
+		{NonCriticalThresholdState, thresholds.LowerNonCritical, thresholds.UpperNonCritical},
+	}
+
+	for _, check := range checks {
+		if check.upper != nil && reading >= *check.upper {
+			return check.state, UpperThresholdBound, check.upper
+		}
+		if check.lower != nil && reading <= *check.lower {
+			return check.state, LowerThresholdBound, check.lower
+		}
+	}
+
+	return NormalThresholdState, "", nil
+}
+
+// threshold gets the value of the threshold of the state at the bound.
+func (thresholds SensorThresholds) threshold(state ThresholdState, bound ThresholdBound) *float32 {
+	upper := bound == UpperThresholdBound
+	switch {
+	case state == FatalThresholdState && upper:
+		return thresholds.UpperFatal
+	case state == FatalThresholdState:
+		return thresholds.LowerFatal
+	case state == CriticalThresholdState && upper:
+		return thresholds.UpperCritical
+	case state == CriticalThresholdState:
+		return thresholds.LowerCritical
+	case state == NonCriticalThresholdState && upper:
+		return thresholds.UpperNonCritical
+	case state == NonCriticalThresholdState:
+		return thresholds.LowerNonCritical
+	}
+	return nil
+}
+
+// SensorEvaluation is the classification of a single sensor.
+type SensorEvaluation struct {
+	// Sensor identifies the sensor, by its location if the service reports
+	// one.
+	Sensor string
+	// Kind is the kind of sensor.
+	Kind SensorKind
+	// PhysicalContext is the area of the chassis the sensor measures.
+	PhysicalContext common.PhysicalContext
+	// Reading is the reading of the sensor, nil if it was not reported.
+	Reading *float32
+	// State is the classification of the reading.
+	State ThresholdState
+	// Bound is the side of the normal range the reading is on, if it is not
+	// normal.
+	Bound ThresholdBound `json:",omitempty"`
+	// Threshold is the value of the threshold the reading crossed, if it is
+	// not normal.
+	Threshold *float32 `json:",omitempty"`
+}
+
+// PhysicalContextSummary rolls up the sensors measuring one area of a
+// chassis.
+type PhysicalContextSummary struct {
+	// PhysicalContext is the area of the chassis.
+	PhysicalContext common.PhysicalContext
+	// State is the most severe state of the sensors with a reading, or
+	// unknown if none of them has one.
+	State ThresholdState
+	// Sensors is the number of sensors in the area.
+	Sensors int
+	// Unreported is the number of sensors without a reading.
+	Unreported int
+}
+
+// ThresholdReport is the classification of the sensors of a chassis. The
+// contexts and sensors are sorted, so reports of the same readings are
+// identical.
+type ThresholdReport struct {
+	// Chassis is the location of the chassis.
+	Chassis string
+	// State is the most severe state of the sensors with a reading, or
+	// unknown if none of them has one.
+	State ThresholdState
+	// PhysicalContexts summarizes the sensors by the area they measure,
+	// sorted by name.
+	PhysicalContexts []PhysicalContextSummary
+	// Sensors has the evaluation of each sensor, sorted by physical
+	// context, kind and sensor.
+	Sensors []SensorEvaluation
+}
+
+// ThresholdEvaluator classifies sensor readings against the thresholds the
+// sensors report. It remembers the state of each sensor, so a reading has to
+// move back past a threshold by the hysteresis of its kind before a less
+// severe state is reported. This prevents alerts from flapping for readings
+// close to a threshold. A ThresholdEvaluator is safe for concurrent use, and
+// its zero value is an evaluator without hysteresis.
+type ThresholdEvaluator struct {
+	// TemperatureHysteresis is the hysteresis of temperatures, in degrees
+	// Celsius.
+	TemperatureHysteresis float32
+	// FanHysteresis is the hysteresis of fans, in the units of their
+	// readings.
+	FanHysteresis float32
+	// VoltageHysteresis is the hysteresis of voltages, in Volts.
+	VoltageHysteresis float32
+
+	mutex    sync.Mutex
+	previous map[string]previousThresholdState
+}
+
+// previousThresholdState is the last state reported for a sensor.
+type previousThresholdState struct {
+	state ThresholdState
+	bound ThresholdBound
+}
+
+// NewThresholdEvaluator creates an evaluator without hysteresis.
+func NewThresholdEvaluator() *ThresholdEvaluator {
+	return &ThresholdEvaluator{previous: make(map[string]previousThresholdState)}
+}
+
+// Evaluate classifies the reading of the sensor identified by the key. A
+// reading that was not reported is unknown, and does not change the state
+// remembered for the sensor.
+func (evaluator *ThresholdEvaluator) Evaluate(key string, reading *float32, thresholds SensorThresholds, hysteresis float32) (ThresholdState, ThresholdBound, *float32) {
+	if reading == nil {
+		return UnknownThresholdState, "", nil
+	}
+
+	state, bound, threshold := thresholds.classify(*reading)
+
+	evaluator.mutex.Lock()
+	defer evaluator.mutex.Unlock()
+
+	if evaluator.previous == nil {
+		evaluator.previous = make(map[string]previousThresholdState)
+	}
+
+	previous, ok := evaluator.previous[key]
+	if ok && hysteresis > 0 && state.severity() < previous.state.severity() {
+		// Only leave the previous state once the reading is past the
+		// threshold by the hysteresis.
+		shifted := *reading - hysteresis
+		if previous.bound == UpperThresholdBound {
+			shifted = *reading + hysteresis
+		}
+
+		held, heldBound, heldThreshold := thresholds.classify(shifted)
+		if held.severity() > previous.state.severity() {
+			// A hysteresis wider than the gap to the next threshold does
+			// not make the state worse, the previous state is held.
+			held, heldBound = previous.state, previous.bound
+			heldThreshold = thresholds.threshold(held, heldBound)
+		}
+		if heldBound == previous.bound && held.severity() > state.severity() {
+			state, bound, threshold = held, heldBound, heldThreshold
+		}
+	}
+
+	evaluator.previous[key] = previousThresholdState{state: state, bound: bound}
+	return state, bound, threshold
+}
+
+// EvaluateChassis reads the thermal and power resources of the chassis and
+// classifies their sensors.
+func (evaluator *ThresholdEvaluator) EvaluateChassis(chassis *Chassis) (*ThresholdReport, error) {
+	thermal, err := chassis.Thermal()
+	if err != nil {
+		return nil, err
+	}
+
+	power, err := chassis.Power()
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluator.EvaluateReadings(chassis.ODataID, thermal, power), nil
+}
+
+// EvaluateReadings classifies the sensors of the thermal and power
+// resources of a chassis, either of which may be nil. Sensors that are
+// absent are left out.
+func (evaluator *ThresholdEvaluator) EvaluateReadings(chassis string, thermal *Thermal, power *Power) *ThresholdReport {
+	report := &ThresholdReport{Chassis: chassis}
+
+	if thermal != nil {
+		for i := range thermal.Temperatures {
+			temperature := &thermal.Temperatures[i]
+			if temperature.Status.State == common.AbsentState {
+				continue
+			}
+			report.Sensors = append(report.Sensors, evaluator.evaluate(
+				sensorKey(chassis, TemperatureSensorKind, temperature.ODataID, temperature.MemberID),
+				TemperatureSensorKind, temperature.PhysicalContext, temperature.ReadingCelsius,
+				temperature.thresholds(), evaluator.TemperatureHysteresis))
+		}
+
+		for i := range thermal.Fans {
+			fan := &thermal.Fans[i]
+			if fan.Status.State == common.AbsentState {
+				continue
+			}
+			report.Sensors = append(report.Sensors, evaluator.evaluate(
+				sensorKey(chassis, FanSensorKind, fan.ODataID, fan.MemberID),
+				FanSensorKind, fan.PhysicalContext, fan.Reading,
+				fan.thresholds(), evaluator.FanHysteresis))
+		}
+	}
+
+	if power != nil {
+		for i := range power.Voltages {
+			voltage := &power.Voltages[i]
+			if voltage.Status.State == common.AbsentState {
+				continue
+			}
+			report.Sensors = append(report.Sensors, evaluator.evaluate(
+				sensorKey(chassis, VoltageSensorKind, voltage.ODataID, voltage.MemberID),
+				VoltageSensorKind, voltage.PhysicalContext, voltage.ReadingVolts,
+				voltage.thresholds(), evaluator.VoltageHysteresis))
+		}
+	}
+
+	sort.Slice(report.Sensors, func(i, j int) bool {
+		a, b := report.Sensors[i], report.Sensors[j]
+		if a.PhysicalContext != b.PhysicalContext {
+			return a.PhysicalContext < b.PhysicalContext
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Sensor < b.Sensor
+	})
+
+	report.State = UnknownThresholdState
+	for _, sensor := range report.Sensors {
+		if len(report.PhysicalContexts) == 0 ||
+			report.PhysicalContexts[len(report.PhysicalContexts)-1].PhysicalContext != sensor.PhysicalContext {
+			report.PhysicalContexts = append(report.PhysicalContexts, PhysicalContextSummary{
+				PhysicalContext: sensor.PhysicalContext,
+				State:           UnknownThresholdState,
+			})
+		}
+
+		summary := &report.PhysicalContexts[len(report.PhysicalContexts)-1]
+		summary.Sensors++
+		if sensor.State == UnknownThresholdState {
+			summary.Unreported++
+		}
+		if sensor.State.severity() > summary.State.severity() {
+			summary.State = sensor.State
+		}
+		if sensor.State.severity() > report.State.severity() {
+			report.State = sensor.State
+		}
+	}
+
+	return report
+}
+
+// evaluate classifies a single sensor for a report.
+func (evaluator *ThresholdEvaluator) evaluate(key string, kind SensorKind, physicalContext string, reading *float32, thresholds SensorThresholds, hysteresis float32) SensorEvaluation {
+	state, bound, threshold := evaluator.Evaluate(key, reading, thresholds, hysteresis)
+	return SensorEvaluation{
+		Sensor:          key,
+		Kind:            kind,
+		PhysicalContext: common.PhysicalContext(physicalContext),
+		Reading:         reading,
+		State:           state,
+		Bound:           bound,
+		Threshold:       threshold,
+	}
+}
+
+// sensorKey identifies a sensor by its location, or by its MemberId within
+// the chassis if the service does not report a location.
+func sensorKey(chassis string, kind SensorKind, odataID, memberID string) string {
+	if odataID != "" {
+		return odataID
+	}
+	return chassis + "#/" + string(kind) + "/" + memberID
+}
@@ -0,0 +1,194 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// TestThresholdEvaluatorHysteresis tests that readings have to move back
+// past a threshold by the hysteresis before the state improves.
+func TestThresholdEvaluatorHysteresis(t *testing.T) {
+	thresholds := SensorThresholds{
+		LowerCritical:    common.Float32(10),
+		UpperNonCritical: common.Float32(80),
+		UpperCritical:    common.Float32(90),
+		UpperFatal:       common.Float32(100),
+	}
+	evaluator := NewThresholdEvaluator()
+
+	steps := []struct {
+		reading float32
+		state   ThresholdState
+		bound   ThresholdBound
+	}{
+		{50, NormalThresholdState, ""},
+		{91, CriticalThresholdState, UpperThresholdBound},
+		{89, CriticalThresholdState, UpperThresholdBound},
+		{87, NonCriticalThresholdState, UpperThresholdBound},
+		{79, NonCriticalThresholdState, UpperThresholdBound},
+		{77, NormalThresholdState, ""},
+		{100, FatalThresholdState, UpperThresholdBound},
+		{99, FatalThresholdState, UpperThresholdBound},
+		{97, CriticalThresholdState, UpperThresholdBound},
+		{9, CriticalThresholdState, LowerThresholdBound},
+		{11, CriticalThresholdState, LowerThresholdBound},
+		{13, NormalThresholdState, ""},
+	}
+
+	for _, step := range steps {
+		state, bound, _ := evaluator.Evaluate("sensor", common.Float32(step.reading), thresholds, 2)
+		if state != step.state || bound != step.bound {
+			t.Errorf("Reading %g: expected %s %s, got %s %s", step.reading, step.bound, step.state, bound, state)
+		}
+	}
+
+	state, _, _ := evaluator.Evaluate("sensor", nil, thresholds, 2)
+	if state != UnknownThresholdState {
+		t.Errorf("Expected unknown state without a reading, got %s", state)
+	}
+}
+
+// TestThresholdEvaluatorWideHysteresis tests that a hysteresis wider than
+// the gap between thresholds holds the previous state.
+func TestThresholdEvaluatorWideHysteresis(t *testing.T) {
+	thresholds := SensorThresholds{
+		UpperNonCritical: common.Float32(80),
+		UpperCritical:    common.Float32(90),
+	}
+	evaluator := NewThresholdEvaluator()
+
+	steps := []struct {
+		reading float32
+		state   ThresholdState
+	}{
+		{85, NonCriticalThresholdState},
+		{79, NonCriticalThresholdState},
+		{64, NormalThresholdState},
+	}
+
+	for _, step := range steps {
+		state, _, threshold := evaluator.Evaluate("sensor", common.Float32(step.reading), thresholds, 15)
+		if state != step.state {
+			t.Errorf("Reading %g: expected %s, got %s", step.reading, step.state, state)
+		}
+		if state == NonCriticalThresholdState && (threshold == nil || *threshold != 80) {
+			t.Errorf("Reading %g: unexpected threshold %v", step.reading, threshold)
+		}
+	}
+}
+
+// TestThresholdEvaluatorZeroValue tests that the zero value can be used.
+func TestThresholdEvaluatorZeroValue(t *testing.T) {
+	thresholds := SensorThresholds{UpperCritical: common.Float32(90)}
+	var evaluator ThresholdEvaluator
+
+	state, bound, _ := evaluator.Evaluate("sensor", common.Float32(91), thresholds, 0)
+	if state != CriticalThresholdState || bound != UpperThresholdBound {
+		t.Errorf("Expected upper critical state, got %s %s", bound, state)
+	}
+}
+
+// TestThresholdEvaluatorReport tests rolling sensors up by physical context.
+func TestThresholdEvaluatorReport(t *testing.T) {
+	var thermal Thermal
+	err := json.Unmarshal([]byte(`{
+		"@odata.id": "/redfish/v1/Chassis/1/Thermal",
+		"Temperatures": [{
+			"@odata.id": "/redfish/v1/Chassis/1/Thermal#/Temperatures/1",
+			"MemberId": "1",
+			"PhysicalContext": "CPU",
+			"ReadingCelsius": 92,
+			"UpperThresholdNonCritical": 80,
+			"UpperThresholdCritical": 90
+		}, {
+			"@odata.id": "/redfish/v1/Chassis/1/Thermal#/Temperatures/0",
+			"MemberId": "0",
+			"PhysicalContext": "CPU",
+			"ReadingCelsius": 40,
+			"UpperThresholdCritical": 90
+		}, {
+			"@odata.id": "/redfish/v1/Chassis/1/Thermal#/Temperatures/2",
+			"MemberId": "2",
+			"PhysicalContext": "Intake",
+			"ReadingCelsius": null,
+			"UpperThresholdCritical": 40
+		}, {
+			"@odata.id": "/redfish/v1/Chassis/1/Thermal#/Temperatures/3",
+			"MemberId": "3",
+			"PhysicalContext": "Intake",
+			"Status": {"State": "Absent"}
+		}],
+		"Fans": [{
+			"MemberId": "0",
+			"PhysicalContext": "SystemBoard",
+			"Reading": 900,
+			"ReadingUnits": "RPM",
+			"LowerThresholdNonCritical": 1000,
+			"LowerThresholdCritical": 500
+		}]
+	}`), &thermal)
+	if err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+
+	var power Power
+	err = json.Unmarshal([]byte(`{
+		"Voltages": [{
+			"@odata.id": "/redfish/v1/Chassis/1/Power#/Voltages/0",
+			"MemberId": "0",
+			"PhysicalContext": "SystemBoard",
+			"ReadingVolts": 12.1,
+			"LowerThresholdCritical": 11,
+			"UpperThresholdCritical": 13
+		}]
+	}`), &power)
+	if err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+
+	report := NewThresholdEvaluator().EvaluateReadings("/redfish/v1/Chassis/1", &thermal, &power)
+
+	if report.State != CriticalThresholdState {
+		t.Errorf("Expected critical chassis, got %s", report.State)
+	}
+
+	expectedSensors := []struct {
+		sensor string
+		state  ThresholdState
+	}{
+		{"/redfish/v1/Chassis/1/Thermal#/Temperatures/0", NormalThresholdState},
+		{"/redfish/v1/Chassis/1/Thermal#/Temperatures/1", CriticalThresholdState},
+		{"/redfish/v1/Chassis/1/Thermal#/Temperatures/2", UnknownThresholdState},
+		{"/redfish/v1/Chassis/1#/Fan/0", NonCriticalThresholdState},
+		{"/redfish/v1/Chassis/1/Power#/Voltages/0", NormalThresholdState},
+	}
+	if len(report.Sensors) != len(expectedSensors) {
+		t.Fatalf("Expected %d sensors, got %d", len(expectedSensors), len(report.Sensors))
+	}
+	for i, expected := range expectedSensors {
+		if report.Sensors[i].Sensor != expected.sensor || report.Sensors[i].State != expected.state {
+			t.Errorf("Expected sensor %d to be %s %s, got %s %s", i,
+				expected.sensor, expected.state, report.Sensors[i].Sensor, report.Sensors[i].State)
+		}
+	}
+
+	expectedContexts := []PhysicalContextSummary{
+		{PhysicalContext: common.CPUPhysicalContext, State: CriticalThresholdState, Sensors: 2},
+		{PhysicalContext: common.IntakePhysicalContext, State: UnknownThresholdState, Sensors: 1, Unreported: 1},
+		{PhysicalContext: common.SystemBoardPhysicalContext, State: NonCriticalThresholdState, Sensors: 2},
+	}
+	if len(report.PhysicalContexts) != len(expectedContexts) {
+		t.Fatalf("Expected %d contexts, got %d", len(expectedContexts), len(report.PhysicalContexts))
+	}
+	for i, expected := range expectedContexts {
+		if report.PhysicalContexts[i] != expected {
+			t.Errorf("Expected context %v, got %v", expected, report.PhysicalContexts[i])
+		}
+	}
+}
@@ -0,0 +1,233 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// TriggerMetricType is the type of metric a trigger applies to.
+type TriggerMetricType string
+
+const (
+	// NumericTriggerMetricType The trigger is for numeric sensor.
+	NumericTriggerMetricType TriggerMetricType = "Numeric"
+	// DiscreteTriggerMetricType The trigger is for a discrete sensor.
+	DiscreteTriggerMetricType TriggerMetricType = "Discrete"
+)
+
+// DiscreteTriggerConditionEnum is the condition that causes a discrete
+// trigger to fire.
+type DiscreteTriggerConditionEnum string
+
+const (
+	// SpecifiedDiscreteTriggerConditionEnum A discrete trigger condition is
+	// met when the metric value becomes one of the values that the
+	// DiscreteTriggers property lists.
+	SpecifiedDiscreteTriggerConditionEnum DiscreteTriggerConditionEnum = "Specified"
+	// ChangedDiscreteTriggerConditionEnum A discrete trigger condition is met
+	// whenever the metric value changes.
+	ChangedDiscreteTriggerConditionEnum DiscreteTriggerConditionEnum = "Changed"
+)
+
+// TriggerActionEnum is the action to perform when a trigger condition is met.
+type TriggerActionEnum string
+
+const (
+	// LogToLogServiceTriggerActionEnum When a trigger condition is met, the
+	// service shall log the occurrence of the condition to the log that the
+	// LogService property in the telemetry service resource describes.
+	LogToLogServiceTriggerActionEnum TriggerActionEnum = "LogToLogService"
+	// RedfishEventTriggerActionEnum When a trigger condition is met, the
+	// service shall send an event to subscribers.
+	RedfishEventTriggerActionEnum TriggerActionEnum = "RedfishEvent"
+	// RedfishMetricReportTriggerActionEnum When a trigger condition is met,
+	// the service shall produce the metric reports that the
+	// MetricReportDefinitions link describes.
+	RedfishMetricReportTriggerActionEnum TriggerActionEnum = "RedfishMetricReport"
+)
+
+// ThresholdActivation is the direction of crossing that activates a
+// threshold.
+type ThresholdActivation string
+
+const (
+	// IncreasingThresholdActivation Value increases above the threshold.
+	IncreasingThresholdActivation ThresholdActivation = "Increasing"
+	// DecreasingThresholdActivation Value decreases below the threshold.
+	DecreasingThresholdActivation ThresholdActivation = "Decreasing"
+	// EitherThresholdActivation Value crosses the threshold in either
+	// direction.
+	EitherThresholdActivation ThresholdActivation = "Either"
+)
+
+// Threshold shall contain the properties for an individual threshold for
+// this sensor.
+type Threshold struct {
+	// Activation shall indicate the direction of crossing of the reading for
+	// this sensor that activates the threshold.
+	Activation ThresholdActivation
+	// DwellTime shall indicate the duration the sensor value must violate the
+	// threshold before the threshold is activated.
+	DwellTime string
+	// Reading shall indicate the reading for this sensor that activates the
+	// threshold.
+	Reading float32
+}
+
+// Thresholds shall contain a set of thresholds for a sensor.
+type Thresholds struct {
+	// LowerCritical shall contain the value at which the reading is below
+	// normal range but not yet fatal.
+	LowerCritical Threshold
+	// LowerWarning shall contain the value at which the reading is below
+	// normal range.
+	LowerWarning Threshold
+	// UpperCritical shall contain the value at which the reading is above
+	// normal range but not yet fatal.
+	UpperCritical Threshold
+	// UpperWarning shall contain the value at which the reading is above
+	// normal range.
+	UpperWarning Threshold
+}
+
+// DiscreteTrigger shall contain the characteristics of the discrete trigger.
+type DiscreteTrigger struct {
+	// DwellTime shall contain the amount of time that a trigger event
+	// persists before the metric action is performed.
+	DwellTime string
+	// Name shall contain a name for the trigger.
+	Name string
+	// Severity shall contain the severity of the event message.
+	Severity common.Health
+	// Value shall contain the value that sets a trigger.
+	Value string
+}
+
+// Triggers shall contain a trigger that applies to metrics.
+type Triggers struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// DiscreteTriggerCondition shall contain the conditions when a discrete
+	// metric triggers.
+	DiscreteTriggerCondition DiscreteTriggerConditionEnum
+	// DiscreteTriggers shall contain a list of values to which to compare a
+	// metric reading.
+	DiscreteTriggers []DiscreteTrigger
+	// EventTriggers shall contain an array of MessageIds that specify when a
+	// trigger condition is met based on an event.
+	EventTriggers []string
+	// MetricProperties shall contain a list of URIs with wildcards and
+	// property identifiers for this trigger.
+	MetricProperties []string
+	// MetricType shall contain the metric type of the trigger.
+	MetricType TriggerMetricType
+	// NumericThresholds shall contain the list of thresholds to which to
+	// compare a numeric metric value.
+	NumericThresholds Thresholds
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// TriggerActions shall contain the actions that the trigger initiates.
+	TriggerActions []TriggerActionEnum
+	// Wildcards shall contain the wildcards and their substitution values
+	// for the entries in the MetricProperties array property.
+	Wildcards []Wildcard
+	// metricReportDefinitions shall contain the metric report definitions
+	// that generate new metric reports when a trigger condition is met.
+	metricReportDefinitions []string
+}
+
+// UnmarshalJSON unmarshals a Triggers object from the raw JSON.
+func (triggers *Triggers) UnmarshalJSON(b []byte) error {
+	type temp Triggers
+	type links struct {
+		MetricReportDefinitions common.Links
+	}
+	var t struct {
+		temp
+		Links links
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*triggers = Triggers(t.temp)
+
+	// Extract the links to other entities for later
+	triggers.metricReportDefinitions = t.Links.MetricReportDefinitions.ToStrings()
+
+	return nil
+}
+
+// GetTriggers will get a Triggers instance from the service.
+func GetTriggers(c common.Client, uri string) (*Triggers, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var triggers Triggers
+	err = json.NewDecoder(resp.Body).Decode(&triggers)
+	if err != nil {
+		return nil, err
+	}
+
+	triggers.SetClient(c)
+	return &triggers, nil
+}
+
+// ListReferencedTriggers gets the collection of Triggers from
+// a provided reference.
+func ListReferencedTriggers(c common.Client, link string) ([]*Triggers, error) {
+	var result []*Triggers
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, triggersLink := range links.ItemLinks {
+		triggers, err := GetTriggers(c, triggersLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, triggers)
+	}
+
+	return result, nil
+}
+
+// MetricReportDefinitions gets the metric report definitions that generate
+// new metric reports when the trigger condition is met.
+func (triggers *Triggers) MetricReportDefinitions() ([]*MetricReportDefinition, error) {
+	var result []*MetricReportDefinition
+	for _, link := range triggers.metricReportDefinitions {
+		definition, err := GetMetricReportDefinition(triggers.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, definition)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,87 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var triggersBody = strings.NewReader(
+	`{
+		"@odata.context": "/redfish/v1/$metadata#Triggers.Triggers",
+		"@odata.type": "#Triggers.v1_1_1.Triggers",
+		"@odata.id": "/redfish/v1/TelemetryService/Triggers/PlatformPowerCapTriggers",
+		"Id": "PlatformPowerCapTriggers",
+		"Name": "Triggers for platform power consumed",
+		"MetricType": "Numeric",
+		"TriggerActions": [
+			"RedfishEvent"
+		],
+		"NumericThresholds": {
+			"UpperCritical": {
+				"Reading": 50,
+				"Activation": "Increasing",
+				"DwellTime": "PT0.001S"
+			},
+			"UpperWarning": {
+				"Reading": 48.1,
+				"Activation": "Increasing",
+				"DwellTime": "PT0.004S"
+			}
+		},
+		"EventTriggers": [
+			"Base.1.0.PropertyValueModified"
+		],
+		"MetricProperties": [
+			"/redfish/v1/Chassis/1/Power#/PowerControl/0/PowerConsumedWatts"
+		],
+		"Links": {
+			"MetricReportDefinitions": [
+				{
+					"@odata.id": "/redfish/v1/TelemetryService/MetricReportDefinitions/PowerMetrics"
+				}
+			]
+		}
+	}`)
+
+// TestTriggers tests the parsing of Triggers objects.
+func TestTriggers(t *testing.T) {
+	var result Triggers
+	err := json.NewDecoder(triggersBody).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "PlatformPowerCapTriggers" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.MetricType != NumericTriggerMetricType {
+		t.Errorf("Invalid metric type: %s", result.MetricType)
+	}
+
+	if result.TriggerActions[0] != RedfishEventTriggerActionEnum {
+		t.Errorf("Invalid trigger action: %s", result.TriggerActions[0])
+	}
+
+	if result.NumericThresholds.UpperCritical.Reading != 50 {
+		t.Errorf("Invalid upper critical reading: %f", result.NumericThresholds.UpperCritical.Reading)
+	}
+
+	if result.NumericThresholds.UpperWarning.Activation != IncreasingThresholdActivation {
+		t.Errorf("Invalid activation: %s", result.NumericThresholds.UpperWarning.Activation)
+	}
+
+	if result.EventTriggers[0] != "Base.1.0.PropertyValueModified" {
+		t.Errorf("Invalid event trigger: %s", result.EventTriggers[0])
+	}
+
+	if len(result.metricReportDefinitions) != 1 {
+		t.Errorf("Invalid metric report definition links: %v", result.metricReportDefinitions)
+	}
+}
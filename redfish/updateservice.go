@@ -0,0 +1,365 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// TransferProtocolType is the protocol used to transfer an image.
+type TransferProtocolType string
+
+const (
+	// CIFSTransferProtocolType Common Internet File System protocol.
+	CIFSTransferProtocolType TransferProtocolType = "CIFS"
+	// FTPTransferProtocolType File Transfer Protocol.
+	FTPTransferProtocolType TransferProtocolType = "FTP"
+	// SFTPTransferProtocolType Secure File Transfer Protocol.
+	SFTPTransferProtocolType TransferProtocolType = "SFTP"
+	// HTTPTransferProtocolType Hypertext Transfer Protocol.
+	HTTPTransferProtocolType TransferProtocolType = "HTTP"
+	// HTTPSTransferProtocolType HTTP Secure protocol.
+	HTTPSTransferProtocolType TransferProtocolType = "HTTPS"
+	// NSFTransferProtocolType Network File System protocol.
+	NSFTransferProtocolType TransferProtocolType = "NSF"
+	// SCPTransferProtocolType Secure File Copy protocol.
+	SCPTransferProtocolType TransferProtocolType = "SCP"
+	// TFTPTransferProtocolType Trivial File Transfer Protocol.
+	TFTPTransferProtocolType TransferProtocolType = "TFTP"
+	// OEMTransferProtocolType A protocol defined by the manufacturer.
+	OEMTransferProtocolType TransferProtocolType = "OEM"
+)
+
+// HTTPPushURIApplyTime shall contain settings for when to apply HttpPushUri
+// provided software.
+type HTTPPushURIApplyTime struct {
+	// ApplyTime shall indicate the time when to apply the HttpPushUri
+	// provided software update.
+	ApplyTime common.OperationApplyTime
+	// MaintenanceWindowDurationInSeconds shall indicate the end of the
+	// maintenance window as the number of seconds after the time specified
+	// by the MaintenanceWindowStartTime property.
+	MaintenanceWindowDurationInSeconds int `json:",omitempty"`
+	// MaintenanceWindowStartTime shall indicate the date and time as to
+	// when the service is allowed to start applying the HttpPushUri
+	// provided software as part of a maintenance window.
+	MaintenanceWindowStartTime string `json:",omitempty"`
+}
+
+// HTTPPushURIOptions shall contain settings and requirements of the service
+// for HttpPushUri-provided software updates.
+type HTTPPushURIOptions struct {
+	// HTTPPushURIApplyTime shall contain settings for when to apply
+	// HttpPushUri-provided firmware.
+	HTTPPushURIApplyTime HTTPPushURIApplyTime `json:"HttpPushUriApplyTime"`
+}
+
+// UpdateService is used to represent the update service offered by the
+// Redfish service.
+type UpdateService struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// Description provides a description of this resource.
+	Description string
+	// firmwareInventory shall be a link to a resource of type
+	// SoftwareInventoryCollection.
+	firmwareInventory string
+	// HTTPPushURI shall contain a URI at which the UpdateService supports an
+	// HTTP or HTTPS POST of a software image for the purpose of installing
+	// software contained within the image.
+	HTTPPushURI string `json:"HttpPushUri"`
+	// HTTPPushURIOptions shall contain options and requirements of the
+	// service for HttpPushUri-provided software updates.
+	HTTPPushURIOptions HTTPPushURIOptions `json:"HttpPushUriOptions"`
+	// HTTPPushURIOptionsBusy shall indicate whether a client uses the
+	// HttpPushUriOptions properties for software updates.
+	HTTPPushURIOptionsBusy bool `json:"HttpPushUriOptionsBusy"`
+	// HTTPPushURITargets shall contain zero or more URIs that indicate where
+	// to apply the update image when using the URI specified by the
+	// HttpPushUri property to push a software image.
+	HTTPPushURITargets []string `json:"HttpPushUriTargets"`
+	// HTTPPushURITargetsBusy shall indicate whether any client has reserved
+	// the HttpPushUriTargets property for firmware updates.
+	HTTPPushURITargetsBusy bool `json:"HttpPushUriTargetsBusy"`
+	// MaxImageSizeBytes shall indicate the maximum size of the software
+	// update image that clients can send to this update service.
+	MaxImageSizeBytes int64
+	// MultipartHTTPPushURI shall contain a URI used to perform a Redfish
+	// Specification-defined multipart HTTP or HTTPS POST of a software image
+	// for the purpose of installing software contained within the image.
+	MultipartHTTPPushURI string `json:"MultipartHttpPushUri"`
+	// ServiceEnabled shall indicate whether this service is enabled.
+	ServiceEnabled bool
+	// softwareInventory shall be a link to a resource of type
+	// SoftwareInventoryCollection.
+	softwareInventory string
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// TransferProtocol is the network protocols the service may use to
+	// retrieve a software image for the SimpleUpdate action.
+	TransferProtocol []TransferProtocolType
+}
+
+// UnmarshalJSON unmarshals an UpdateService object from the raw JSON.
+func (updateservice *UpdateService) UnmarshalJSON(b []byte) error {
+	type temp UpdateService
+	type actions struct {
+		SimpleUpdate struct {
+			AllowableValues []TransferProtocolType `json:"TransferProtocol@Redfish.AllowableValues"`
+		} `json:"#UpdateService.SimpleUpdate"`
+	}
+	var t struct {
+		temp
+		Actions           actions
+		FirmwareInventory common.Link
+		SoftwareInventory common.Link
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*updateservice = UpdateService(t.temp)
+
+	// Extract the links to other entities for later
+	updateservice.TransferProtocol = t.Actions.SimpleUpdate.AllowableValues
+	updateservice.firmwareInventory = string(t.FirmwareInventory)
+	updateservice.softwareInventory = string(t.SoftwareInventory)
+
+	return nil
+}
+
+// GetUpdateService will get an UpdateService instance from the service.
+func GetUpdateService(c common.Client, uri string) (*UpdateService, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var updateservice UpdateService
+	err = json.NewDecoder(resp.Body).Decode(&updateservice)
+	if err != nil {
+		return nil, err
+	}
+
+	updateservice.SetClient(c)
+	return &updateservice, nil
+}
+
+// UpdateParameters are the settings sent along with a software image pushed
+// to the service.
+type UpdateParameters struct {
+	// Targets shall contain zero or more URIs indicating where to apply the
+	// update image. If empty, the service decides where to apply the image.
+	Targets []string `json:",omitempty"`
+	// OperationApplyTime shall indicate when the service applies the update.
+	OperationApplyTime common.OperationApplyTime `json:"@Redfish.OperationApplyTime,omitempty"`
+	// ForceUpdate shall indicate whether the service should bypass update
+	// policies when applying the image, such as allowing a component to be
+	// downgraded.
+	ForceUpdate bool `json:",omitempty"`
+	// Progress, if set, is called as the image is uploaded with the number of
+	// bytes sent so far and the total size of the image, or -1 if the size
+	// is not known. It is not sent to the service.
+	Progress func(sent, total int64) `json:"-"`
+}
+
+// MultipartUpdate pushes a software image to the service and returns the
+// monitor for the resulting update task. The image is streamed from the
+// reader rather than loaded into memory. If the reader has a Name method,
+// such as an *os.File, it is used as the file name of the image.
+//
+// The MultipartHttpPushUri is used when the service provides one. Otherwise
+// the image is posted to the legacy HttpPushUri, after setting the
+// HttpPushUriTargets and apply time on the service as those cannot be sent
+// with the image. ForceUpdate is not supported by the legacy method.
+func (updateservice *UpdateService) MultipartUpdate(image io.Reader, parameters UpdateParameters) (*TaskMonitor, error) {
+	size := readerSize(image)
+	filename := "image"
+	if named, ok := image.(interface{ Name() string }); ok {
+		filename = filepath.Base(named.Name())
+	}
+
+	if updateservice.MaxImageSizeBytes > 0 && size > updateservice.MaxImageSizeBytes {
+		return nil, fmt.Errorf("image size %d exceeds the maximum of %d bytes", size, updateservice.MaxImageSizeBytes)
+	}
+
+	if parameters.Progress != nil {
+		image = &progressReader{r: image, total: size, progress: parameters.Progress}
+	}
+
+	if updateservice.MultipartHTTPPushURI != "" {
+		return updateservice.multipartPush(image, filename, size, parameters)
+	}
+
+	if updateservice.HTTPPushURI != "" {
+		return updateservice.legacyPush(image, size, parameters)
+	}
+
+	return nil, fmt.Errorf("update service does not support pushing images")
+}
+
+func (updateservice *UpdateService) multipartPush(image io.Reader, filename string, size int64, parameters UpdateParameters) (*TaskMonitor, error) {
+	payload, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the leading and trailing parts of the body are built in memory,
+	// the image itself is streamed between them.
+	var head bytes.Buffer
+	writer := multipart.NewWriter(&head)
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {`form-data; name="UpdateParameters"`},
+		"Content-Type":        {"application/json"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = part.Write(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// The file name comes from the caller, so it is quoted and escaped as
+	// needed rather than interpolated.
+	disposition := mime.FormatMediaType("form-data", map[string]string{
+		"name":     "UpdateFile",
+		"filename": filename,
+	})
+	if disposition == "" {
+		return nil, fmt.Errorf("invalid image file name %q", filename)
+	}
+
+	_, err = writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {disposition},
+		"Content-Type":        {"application/octet-stream"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The writer is not closed as that would need to come after the image,
+	// so the closing boundary is written out separately.
+	tail := fmt.Sprintf("\r\n--%s--\r\n", writer.Boundary())
+
+	bodySize := int64(-1)
+	if size >= 0 {
+		bodySize = int64(head.Len()) + size + int64(len(tail))
+	}
+
+	body := io.MultiReader(&head, image, strings.NewReader(tail))
+	resp, err := updateservice.Client.PostStream(updateservice.MultipartHTTPPushURI, writer.FormDataContentType(), body, bodySize)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTaskMonitor(updateservice.Client, resp)
+}
+
+func (updateservice *UpdateService) legacyPush(image io.Reader, size int64, parameters UpdateParameters) (*TaskMonitor, error) {
+	if len(parameters.Targets) > 0 || parameters.OperationApplyTime != "" {
+		type temp struct {
+			HTTPPushURITargets []string            `json:"HttpPushUriTargets,omitempty"`
+			HTTPPushURIOptions *HTTPPushURIOptions `json:"HttpPushUriOptions,omitempty"`
+		}
+		t := temp{HTTPPushURITargets: parameters.Targets}
+		if parameters.OperationApplyTime != "" {
+			t.HTTPPushURIOptions = &HTTPPushURIOptions{
+				HTTPPushURIApplyTime: HTTPPushURIApplyTime{ApplyTime: parameters.OperationApplyTime},
+			}
+		}
+
+		payload, err := json.Marshal(t)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := updateservice.Client.Patch(updateservice.ODataID, payload)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body.Close()
+	}
+
+	resp, err := updateservice.Client.PostStream(updateservice.HTTPPushURI, "application/octet-stream", image, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTaskMonitor(updateservice.Client, resp)
+}
+
+// readerSize returns the number of bytes remaining in the reader, or -1 if
+// that cannot be determined without consuming it.
+func readerSize(r io.Reader) int64 {
+	seeker, ok := r.(io.Seeker)
+	if !ok {
+		return -1
+	}
+
+	current, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return -1
+	}
+	end, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return -1
+	}
+	_, err = seeker.Seek(current, io.SeekStart)
+	if err != nil {
+		return -1
+	}
+
+	return end - current
+}
+
+// progressReader reports the number of bytes read through it.
+type progressReader struct {
+	r        io.Reader
+	sent     int64
+	total    int64
+	progress func(sent, total int64)
+}
+
+func (pr *progressReader) Read(p []byte) (int, error) {
+	n, err := pr.r.Read(p)
+	if n > 0 {
+		pr.sent += int64(n)
+		pr.progress(pr.sent, pr.total)
+	}
+	return n, err
+}
+
+// FirmwareInventories gets the firmware inventory of the service.
+func (updateservice *UpdateService) FirmwareInventories() ([]*SoftwareInventory, error) {
+	return ListReferencedSoftwareInventories(updateservice.Client, updateservice.firmwareInventory)
+}
+
+// SoftwareInventories gets the software inventory of the service.
+func (updateservice *UpdateService) SoftwareInventories() ([]*SoftwareInventory, error) {
+	return ListReferencedSoftwareInventories(updateservice.Client, updateservice.softwareInventory)
+}
@@ -0,0 +1,205 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+var updateServiceBody = `{
+		"@odata.context": "/redfish/v1/$metadata#UpdateService.UpdateService",
+		"@odata.type": "#UpdateService.v1_8_0.UpdateService",
+		"@odata.id": "/redfish/v1/UpdateService",
+		"Id": "UpdateService",
+		"Name": "Update service",
+		"Status": {
+			"State": "Enabled",
+			"Health": "OK"
+		},
+		"ServiceEnabled": true,
+		"HttpPushUri": "/redfish/v1/UpdateService/update",
+		"MultipartHttpPushUri": "/redfish/v1/UpdateService/upload",
+		"MaxImageSizeBytes": 536870912,
+		"HttpPushUriTargets": [],
+		"HttpPushUriTargetsBusy": false,
+		"FirmwareInventory": {
+			"@odata.id": "/redfish/v1/UpdateService/FirmwareInventory"
+		},
+		"SoftwareInventory": {
+			"@odata.id": "/redfish/v1/UpdateService/SoftwareInventory"
+		},
+		"Actions": {
+			"#UpdateService.SimpleUpdate": {
+				"target": "/redfish/v1/UpdateService/Actions/SimpleUpdate",
+				"TransferProtocol@Redfish.AllowableValues": [
+					"HTTP",
+					"HTTPS"
+				]
+			}
+		}
+	}`
+
+// TestUpdateService tests the parsing of UpdateService objects.
+func TestUpdateService(t *testing.T) {
+	var result UpdateService
+	err := json.NewDecoder(strings.NewReader(updateServiceBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "UpdateService" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.MultipartHTTPPushURI != "/redfish/v1/UpdateService/upload" {
+		t.Errorf("Invalid multipart push URI: %s", result.MultipartHTTPPushURI)
+	}
+
+	if result.MaxImageSizeBytes != 536870912 {
+		t.Errorf("Invalid max image size: %d", result.MaxImageSizeBytes)
+	}
+
+	if result.firmwareInventory != "/redfish/v1/UpdateService/FirmwareInventory" {
+		t.Errorf("Invalid firmware inventory link: %s", result.firmwareInventory)
+	}
+
+	if len(result.TransferProtocol) != 2 {
+		t.Errorf("Expected 2 transfer protocols, got %d", len(result.TransferProtocol))
+	}
+}
+
+// TestUpdateServiceMultipartUpdate tests the multipart push of an image.
+func TestUpdateServiceMultipartUpdate(t *testing.T) {
+	var result UpdateService
+	err := json.NewDecoder(strings.NewReader(updateServiceBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{
+		headers: map[string]http.Header{
+			"POST /redfish/v1/UpdateService/upload": {"Location": {"/redfish/v1/TaskService/TaskMonitors/1"}},
+		},
+		responses: map[string]string{
+			"POST /redfish/v1/UpdateService/upload": `{"@odata.id": "/redfish/v1/TaskService/Tasks/1"}`,
+		},
+	}
+	result.SetClient(client)
+
+	var sent int64
+	monitor, err := result.MultipartUpdate(strings.NewReader("firmware image"), UpdateParameters{
+		Targets:            []string{"/redfish/v1/UpdateService/FirmwareInventory/BMC"},
+		OperationApplyTime: common.OnResetOperationApplyTime,
+		Progress:           func(s, total int64) { sent = s },
+	})
+	if err != nil {
+		t.Errorf("Error pushing image: %s", err)
+	}
+
+	if monitor.URI != "/redfish/v1/TaskService/TaskMonitors/1" {
+		t.Errorf("Invalid task monitor: %s", monitor.URI)
+	}
+
+	if monitor.TaskURI != "/redfish/v1/TaskService/Tasks/1" {
+		t.Errorf("Invalid task: %s", monitor.TaskURI)
+	}
+
+	if sent != 14 {
+		t.Errorf("Expected 14 bytes of progress, got %d", sent)
+	}
+
+	call := client.calls[0]
+	_, params, err := mime.ParseMediaType(call.ContentType)
+	if err != nil {
+		t.Errorf("Invalid content type: %s", call.ContentType)
+	}
+
+	reader := multipart.NewReader(strings.NewReader(call.Payload), params["boundary"])
+	part, err := reader.NextPart()
+	if err != nil {
+		t.Errorf("Error reading parameters part: %s", err)
+	}
+	var parameters map[string]interface{}
+	err = json.NewDecoder(part).Decode(&parameters)
+	if err != nil {
+		t.Errorf("Error decoding parameters: %s", err)
+	}
+	if parameters["@Redfish.OperationApplyTime"] != "OnReset" {
+		t.Errorf("Invalid apply time: %v", parameters["@Redfish.OperationApplyTime"])
+	}
+
+	part, err = reader.NextPart()
+	if err != nil {
+		t.Errorf("Error reading file part: %s", err)
+	}
+	if part.FormName() != "UpdateFile" {
+		t.Errorf("Invalid file part name: %s", part.FormName())
+	}
+	image, _ := ioutil.ReadAll(part)
+	if string(image) != "firmware image" {
+		t.Errorf("Invalid image contents: %s", image)
+	}
+
+	_, err = reader.NextPart()
+	if err == nil {
+		t.Error("Expected the body to end after the image")
+	}
+}
+
+// namedReader is an image reader with a file name, like an *os.File.
+type namedReader struct {
+	*strings.Reader
+	name string
+}
+
+func (r namedReader) Name() string {
+	return r.name
+}
+
+// TestUpdateServiceMultipartUpdateFileName tests that the file name of the
+// image is escaped in the multipart body.
+func TestUpdateServiceMultipartUpdateFileName(t *testing.T) {
+	client := &testClient{
+		headers: map[string]http.Header{
+			"POST /redfish/v1/UpdateService/upload": {"Location": {"/redfish/v1/TaskService/TaskMonitors/1"}},
+		},
+	}
+	result := UpdateService{MultipartHTTPPushURI: "/redfish/v1/UpdateService/upload"}
+	result.SetClient(client)
+
+	name := `/images/bmc "v2"; name=x.bin`
+	_, err := result.MultipartUpdate(namedReader{strings.NewReader("firmware image"), name}, UpdateParameters{})
+	if err != nil {
+		t.Fatalf("Error pushing image: %s", err)
+	}
+
+	call := client.calls[0]
+	_, params, err := mime.ParseMediaType(call.ContentType)
+	if err != nil {
+		t.Errorf("Invalid content type: %s", call.ContentType)
+	}
+
+	reader := multipart.NewReader(strings.NewReader(call.Payload), params["boundary"])
+	_, err = reader.NextPart()
+	if err != nil {
+		t.Errorf("Error reading parameters part: %s", err)
+	}
+	part, err := reader.NextPart()
+	if err != nil {
+		t.Fatalf("Error reading file part: %s", err)
+	}
+	if part.FormName() != "UpdateFile" || part.FileName() != `bmc "v2"; name=x.bin` {
+		t.Errorf("Invalid file part: %s %s", part.FormName(), part.FileName())
+	}
+}
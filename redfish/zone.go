@@ -0,0 +1,335 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+// ZoneType is the type of zone.
+type ZoneType string
+
+const (
+	// DefaultZoneType The zone in which all endpoints are added by default
+	// when instantiated.
+	DefaultZoneType ZoneType = "Default"
+	// ZoneOfEndpointsZoneType A zone that contains endpoints.
+	ZoneOfEndpointsZoneType ZoneType = "ZoneOfEndpoints"
+	// ZoneOfZonesZoneType A zone that contains zones.
+	ZoneOfZonesZoneType ZoneType = "ZoneOfZones"
+	// ZoneOfResourceBlocksZoneType A zone that contains resource blocks.
+	ZoneOfResourceBlocksZoneType ZoneType = "ZoneOfResourceBlocks"
+)
+
+// Zone shall contain a simple zone for a Redfish implementation.
+type Zone struct {
+	common.Entity
+
+	// ODataContext is the odata context.
+	ODataContext string `json:"@odata.context"`
+	// ODataEtag is the odata etag.
+	ODataEtag string `json:"@odata.etag"`
+	// ODataID is the odata identifier.
+	ODataID string `json:"@odata.id"`
+	// ODataType is the odata type.
+	ODataType string `json:"@odata.type"`
+	// CollectionCapabilities shall describe the composition requests the
+	// resource zone supports.
+	CollectionCapabilities common.CollectionCapabilities `json:"@Redfish.CollectionCapabilities"`
+	// DefaultRoutingEnabled shall indicate whether routing within this zone
+	// is enabled.
+	DefaultRoutingEnabled bool
+	// Description provides a description of this resource.
+	Description string
+	// ExternalAccessibility shall contain an indication of accessibility of
+	// endpoints in this zone to endpoints outside of this zone.
+	ExternalAccessibility string
+	// Identifiers shall contain a list of all known durable names for the
+	// associated zone.
+	Identifiers []common.Identifier
+	// Status shall contain any status or health properties of the resource.
+	Status common.Status
+	// ZoneType shall contain the type of the zone.
+	ZoneType ZoneType
+	// addressPools shall contain an array of links to resources of type
+	// AddressPool with which this zone is associated.
+	addressPools []string
+	// containedByZones shall contain an array of links to resources of type
+	// Zone that represent the zones that contain this zone.
+	containedByZones []string
+	// containsZones shall contain an array of links to resources of type
+	// Zone that represent the zones that are contained by this zone.
+	containsZones []string
+	// endpoints shall contain an array of links to resources of type
+	// Endpoint that this zone contains.
+	endpoints []string
+	// involvedSwitches shall contain an array of links to resources of type
+	// Switch in this fabric that this zone involves.
+	involvedSwitches []string
+	// resourceBlocks shall contain an array of links to resources of type
+	// ResourceBlock with which this zone is associated.
+	resourceBlocks []string
+	// EndpointsCount is the number of endpoints in the zone.
+	EndpointsCount int
+}
+
+// UnmarshalJSON unmarshals a Zone object from the raw JSON.
+func (zone *Zone) UnmarshalJSON(b []byte) error {
+	type temp Zone
+	type links struct {
+		AddressPools     common.Links
+		ContainedByZones common.Links
+		ContainsZones    common.Links
+		Endpoints        common.Links
+		EndpointsCount   int `json:"Endpoints@odata.count"`
+		InvolvedSwitches common.Links
+		ResourceBlocks   common.Links
+	}
+	var t struct {
+		temp
+		Links links
+	}
+
+	err := json.Unmarshal(b, &t)
+	if err != nil {
+		return err
+	}
+
+	*zone = Zone(t.temp)
+
+	// Extract the links to other entities for later
+	zone.addressPools = t.Links.AddressPools.ToStrings()
+	zone.containedByZones = t.Links.ContainedByZones.ToStrings()
+	zone.containsZones = t.Links.ContainsZones.ToStrings()
+	zone.endpoints = t.Links.Endpoints.ToStrings()
+	zone.EndpointsCount = t.Links.EndpointsCount
+	zone.involvedSwitches = t.Links.InvolvedSwitches.ToStrings()
+	zone.resourceBlocks = t.Links.ResourceBlocks.ToStrings()
+
+	return nil
+}
+
+// GetZone will get a Zone instance from the service.
+func GetZone(c common.Client, uri string) (*Zone, error) {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var zone Zone
+	err = json.NewDecoder(resp.Body).Decode(&zone)
+	if err != nil {
+		return nil, err
+	}
+
+	zone.SetClient(c)
+	return &zone, nil
+}
+
+// ListReferencedZones gets the collection of Zone from
+// a provided reference.
+func ListReferencedZones(c common.Client, link string) ([]*Zone, error) {
+	var result []*Zone
+	if link == "" {
+		return result, nil
+	}
+
+	links, err := common.GetCollection(c, link)
+	if err != nil {
+		return result, err
+	}
+
+	for _, zoneLink := range links.ItemLinks {
+		zone, err := GetZone(c, zoneLink)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, zone)
+	}
+
+	return result, nil
+}
+
+// AddressPools gets the address pools associated with the zone.
+func (zone *Zone) AddressPools() ([]*AddressPool, error) {
+	var result []*AddressPool
+	for _, link := range zone.addressPools {
+		addressPool, err := GetAddressPool(zone.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, addressPool)
+	}
+
+	return result, nil
+}
+
+// ContainedByZones gets the zones that contain this zone.
+func (zone *Zone) ContainedByZones() ([]*Zone, error) {
+	return zone.getZones(zone.containedByZones)
+}
+
+// ContainsZones gets the zones contained by this zone.
+func (zone *Zone) ContainsZones() ([]*Zone, error) {
+	return zone.getZones(zone.containsZones)
+}
+
+func (zone *Zone) getZones(links []string) ([]*Zone, error) {
+	var result []*Zone
+	for _, link := range links {
+		z, err := GetZone(zone.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, z)
+	}
+
+	return result, nil
+}
+
+// Endpoints gets the endpoints in the zone.
+func (zone *Zone) Endpoints() ([]*Endpoint, error) {
+	var result []*Endpoint
+	for _, link := range zone.endpoints {
+		endpoint, err := GetEndpoint(zone.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, endpoint)
+	}
+
+	return result, nil
+}
+
+// InvolvedSwitches gets the switches the zone involves.
+func (zone *Zone) InvolvedSwitches() ([]*Switch, error) {
+	var result []*Switch
+	for _, link := range zone.involvedSwitches {
+		s, err := GetSwitch(zone.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// ResourceBlocks gets the resource blocks in the resource zone.
+func (zone *Zone) ResourceBlocks() ([]*ResourceBlock, error) {
+	var result []*ResourceBlock
+	for _, link := range zone.resourceBlocks {
+		resourceblock, err := GetResourceBlock(zone.Client, link)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, resourceblock)
+	}
+
+	return result, nil
+}
+
+// SetEndpoints replaces the endpoints in the zone.
+func (zone *Zone) SetEndpoints(endpoints []*Endpoint) error {
+	links := endpointLinks(endpoints)
+	err := zone.patchEndpoints(links)
+	if err != nil {
+		return err
+	}
+
+	zone.endpoints = links.ToStrings()
+	zone.EndpointsCount = len(zone.endpoints)
+	return nil
+}
+
+// AddEndpoints adds endpoints to the zone. Endpoints already in the zone are
+// ignored.
+func (zone *Zone) AddEndpoints(endpoints ...*Endpoint) error {
+	links := common.Links{}
+	member := make(map[string]bool)
+	for _, link := range zone.endpoints {
+		links = append(links, common.Link(link))
+		member[link] = true
+	}
+	for _, endpoint := range endpoints {
+		if !member[endpoint.ODataID] {
+			links = append(links, common.Link(endpoint.ODataID))
+			member[endpoint.ODataID] = true
+		}
+	}
+
+	if len(links) == len(zone.endpoints) {
+		return nil
+	}
+
+	err := zone.patchEndpoints(links)
+	if err != nil {
+		return err
+	}
+
+	zone.endpoints = links.ToStrings()
+	zone.EndpointsCount = len(zone.endpoints)
+	return nil
+}
+
+// RemoveEndpoints removes endpoints from the zone. Endpoints not in the zone
+// are ignored.
+func (zone *Zone) RemoveEndpoints(endpoints ...*Endpoint) error {
+	remove := make(map[string]bool)
+	for _, endpoint := range endpoints {
+		remove[endpoint.ODataID] = true
+	}
+
+	links := common.Links{}
+	for _, link := range zone.endpoints {
+		if !remove[link] {
+			links = append(links, common.Link(link))
+		}
+	}
+
+	if len(links) == len(zone.endpoints) {
+		return nil
+	}
+
+	err := zone.patchEndpoints(links)
+	if err != nil {
+		return err
+	}
+
+	zone.endpoints = links.ToStrings()
+	zone.EndpointsCount = len(zone.endpoints)
+	return nil
+}
+
+func (zone *Zone) patchEndpoints(links common.Links) error {
+	var t struct {
+		Links struct {
+			Endpoints common.Links
+		}
+	}
+	t.Links.Endpoints = links
+
+	resp, err := zone.Patch(zone.ODataID, t)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// Delete removes the zone from the fabric.
+func (zone *Zone) Delete() error {
+	resp, err := zone.Client.Delete(zone.ODataID)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,191 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package redfish
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rocksolidlabs/gofish/common"
+)
+
+var zoneBody = `{
+		"@odata.context": "/redfish/v1/$metadata#Zone.Zone",
+		"@odata.type": "#Zone.v1_4_0.Zone",
+		"@odata.id": "/redfish/v1/Fabrics/NVMeoF/Zones/1",
+		"Id": "1",
+		"Name": "Host 1 storage",
+		"ZoneType": "ZoneOfEndpoints",
+		"Status": {
+			"State": "Enabled",
+			"Health": "OK"
+		},
+		"Links": {
+			"Endpoints@odata.count": 2,
+			"Endpoints": [
+				{"@odata.id": "/redfish/v1/Fabrics/NVMeoF/Endpoints/Initiator1"},
+				{"@odata.id": "/redfish/v1/Fabrics/NVMeoF/Endpoints/Target1"}
+			],
+			"InvolvedSwitches": [
+				{"@odata.id": "/redfish/v1/Fabrics/NVMeoF/Switches/Switch1"}
+			],
+			"AddressPools": [
+				{"@odata.id": "/redfish/v1/Fabrics/NVMeoF/AddressPools/Pool1"}
+			]
+		}
+	}`
+
+// TestZone tests the parsing of Zone objects.
+func TestZone(t *testing.T) {
+	var result Zone
+	err := json.NewDecoder(strings.NewReader(zoneBody)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ID != "1" {
+		t.Errorf("Received invalid ID: %s", result.ID)
+	}
+
+	if result.ZoneType != ZoneOfEndpointsZoneType {
+		t.Errorf("Invalid zone type: %s", result.ZoneType)
+	}
+
+	if result.EndpointsCount != 2 || len(result.endpoints) != 2 {
+		t.Errorf("Invalid endpoints: %v", result.endpoints)
+	}
+
+	if result.involvedSwitches[0] != "/redfish/v1/Fabrics/NVMeoF/Switches/Switch1" {
+		t.Errorf("Invalid involved switches: %v", result.involvedSwitches)
+	}
+
+	if result.addressPools[0] != "/redfish/v1/Fabrics/NVMeoF/AddressPools/Pool1" {
+		t.Errorf("Invalid address pools: %v", result.addressPools)
+	}
+}
+
+// TestZoneMembership tests updating the endpoints in a zone.
+func TestZoneMembership(t *testing.T) {
+	var result Zone
+	err := json.NewDecoder(strings.NewReader(zoneBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	client := &testClient{}
+	result.SetClient(client)
+
+	target1 := &Endpoint{ODataID: "/redfish/v1/Fabrics/NVMeoF/Endpoints/Target1"}
+	target2 := &Endpoint{ODataID: "/redfish/v1/Fabrics/NVMeoF/Endpoints/Target2"}
+
+	err = result.AddEndpoints(target1, target2)
+	if err != nil {
+		t.Errorf("Error adding endpoints: %s", err)
+	}
+
+	expected := `{"Links":{"Endpoints":[{"@odata.id":"/redfish/v1/Fabrics/NVMeoF/Endpoints/Initiator1"},{"@odata.id":"/redfish/v1/Fabrics/NVMeoF/Endpoints/Target1"},{"@odata.id":"/redfish/v1/Fabrics/NVMeoF/Endpoints/Target2"}]}}`
+	if client.calls[0].URL != "/redfish/v1/Fabrics/NVMeoF/Zones/1" || client.calls[0].Payload != expected {
+		t.Errorf("Unexpected add request: %s %s", client.calls[0].URL, client.calls[0].Payload)
+	}
+
+	if result.EndpointsCount != 3 {
+		t.Errorf("Expected 3 endpoints, got %d", result.EndpointsCount)
+	}
+
+	err = result.RemoveEndpoints(target1)
+	if err != nil {
+		t.Errorf("Error removing endpoints: %s", err)
+	}
+
+	expected = `{"Links":{"Endpoints":[{"@odata.id":"/redfish/v1/Fabrics/NVMeoF/Endpoints/Initiator1"},{"@odata.id":"/redfish/v1/Fabrics/NVMeoF/Endpoints/Target2"}]}}`
+	if client.calls[1].Payload != expected {
+		t.Errorf("Unexpected remove payload: %s", client.calls[1].Payload)
+	}
+
+	err = result.AddEndpoints(target2)
+	if err != nil {
+		t.Errorf("Error adding endpoints: %s", err)
+	}
+	if len(client.calls) != 2 {
+		t.Errorf("Adding an existing member should not send a request")
+	}
+
+	err = result.SetEndpoints(nil)
+	if err != nil {
+		t.Errorf("Error setting endpoints: %s", err)
+	}
+	if client.calls[2].Payload != `{"Links":{"Endpoints":[]}}` {
+		t.Errorf("Unexpected set payload: %s", client.calls[2].Payload)
+	}
+}
+
+// TestResourceZone tests the parsing of resource zones.
+func TestResourceZone(t *testing.T) {
+	var result Zone
+	err := json.NewDecoder(strings.NewReader(`{
+		"@odata.type": "#Zone.v1_4_0.Zone",
+		"@odata.id": "/redfish/v1/CompositionService/ResourceZones/1",
+		"Id": "1",
+		"Name": "Resource Zone 1",
+		"ZoneType": "ZoneOfResourceBlocks",
+		"Links": {
+			"ResourceBlocks": [
+				{
+					"@odata.id": "/redfish/v1/CompositionService/ResourceBlocks/ComputeBlock1"
+				},
+				{
+					"@odata.id": "/redfish/v1/CompositionService/ResourceBlocks/DriveBlock3"
+				}
+			]
+		},
+		"@Redfish.CollectionCapabilities": {
+			"@odata.type": "#CollectionCapabilities.v1_1_0.CollectionCapabilities",
+			"Capabilities": [
+				{
+					"CapabilitiesObject": {
+						"@odata.id": "/redfish/v1/Systems/Capabilities"
+					},
+					"UseCase": "ComputerSystemComposition",
+					"Links": {
+						"TargetCollection": {
+							"@odata.id": "/redfish/v1/Systems"
+						}
+					}
+				}
+			]
+		}
+	}`)).Decode(&result)
+
+	if err != nil {
+		t.Errorf("Error decoding JSON: %s", err)
+	}
+
+	if result.ZoneType != ZoneOfResourceBlocksZoneType {
+		t.Errorf("Invalid zone type: %s", result.ZoneType)
+	}
+
+	if len(result.resourceBlocks) != 2 {
+		t.Errorf("Invalid resource blocks: %v", result.resourceBlocks)
+	}
+
+	if len(result.CollectionCapabilities.Capabilities) != 1 {
+		t.Fatalf("Invalid collection capabilities: %v", result.CollectionCapabilities)
+	}
+
+	capability := result.CollectionCapabilities.Capabilities[0]
+	if capability.UseCase != common.ComputerSystemCompositionCollectionCapabilityUseCase {
+		t.Errorf("Invalid use case: %s", capability.UseCase)
+	}
+
+	if capability.CapabilitiesObject != "/redfish/v1/Systems/Capabilities" {
+		t.Errorf("Invalid capabilities object: %s", capability.CapabilitiesObject)
+	}
+
+	if capability.TargetCollection != "/redfish/v1/Systems" {
+		t.Errorf("Invalid target collection: %s", capability.TargetCollection)
+	}
+}
@@ -0,0 +1,182 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package gofish
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/rocksolidlabs/gofish/redfish"
+)
+
+// CredentialRotationOutcome is the result of rotating a credential on a
+// single endpoint.
+type CredentialRotationOutcome string
+
+const (
+	// RotatedCredentialRotationOutcome indicates the new password was set
+	// and a session could be created with it.
+	RotatedCredentialRotationOutcome CredentialRotationOutcome = "Rotated"
+	// RolledBackCredentialRotationOutcome indicates the new password was
+	// set but could not be verified, and the old password was restored.
+	RolledBackCredentialRotationOutcome CredentialRotationOutcome = "RolledBack"
+	// RollbackFailedCredentialRotationOutcome indicates the new password
+	// could not be verified and the old password could not be restored. The
+	// account needs manual attention.
+	RollbackFailedCredentialRotationOutcome CredentialRotationOutcome = "RollbackFailed"
+	// FailedCredentialRotationOutcome indicates the password was not
+	// changed, for example because the account was not found or the service
+	// rejected the new password.
+	FailedCredentialRotationOutcome CredentialRotationOutcome = "Failed"
+)
+
+// CredentialRotation describes the password change to make. The old
+// password is needed to roll back, as services never return passwords.
+type CredentialRotation struct {
+	// UserName is the name of the account to rotate.
+	UserName string
+	// OldPassword is the current password of the account.
+	OldPassword string
+	// NewPassword is the password to set.
+	NewPassword string
+}
+
+// CredentialRotationResult is the result of rotating a credential on a
+// single endpoint.
+type CredentialRotationResult struct {
+	// Endpoint is the URL of the service.
+	Endpoint string
+	// Account is the location of the account on the service, empty if it
+	// was not found.
+	Account string `json:",omitempty"`
+	// Outcome is what happened to the credential.
+	Outcome CredentialRotationOutcome
+	// Error describes why the rotation did not succeed.
+	Error string `json:",omitempty"`
+}
+
+// RotateCredential changes the password of the named account on the service
+// reachable through the client, then verifies it by creating a session with
+// the new password. If verification fails the old password is restored.
+// The client should not be authenticated as the account being rotated, as
+// services may end its sessions when the password changes.
+func RotateCredential(client *ApiClient, rotation CredentialRotation) *CredentialRotationResult {
+	result := &CredentialRotationResult{
+		Endpoint: client.Endpoint,
+		Outcome:  FailedCredentialRotationOutcome,
+	}
+
+	service, err := ServiceRoot(client)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if service.sessions == "" {
+		result.Error = "service does not support sessions, the new password cannot be verified"
+		return result
+	}
+
+	account, err := findAccount(service, rotation.UserName)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Account = account.ODataID
+
+	account.Password = rotation.NewPassword
+	err = account.Update()
+	if err != nil {
+		result.Error = fmt.Sprintf("setting new password: %s", err)
+		return result
+	}
+
+	err = verifyCredential(client, service, rotation.UserName, rotation.NewPassword)
+	if err == nil {
+		result.Outcome = RotatedCredentialRotationOutcome
+		return result
+	}
+
+	account.Password = rotation.OldPassword
+	rollbackErr := account.Update()
+	if rollbackErr != nil {
+		result.Outcome = RollbackFailedCredentialRotationOutcome
+		result.Error = fmt.Sprintf("verifying new password: %s; restoring old password: %s", err, rollbackErr)
+		return result
+	}
+
+	result.Outcome = RolledBackCredentialRotationOutcome
+	result.Error = fmt.Sprintf("verifying new password: %s", err)
+	return result
+}
+
+// RotateFleetCredentials rotates the credential on each client, running at
+// most concurrency rotations at once. Every endpoint gets a result, in the
+// order of the clients.
+func RotateFleetCredentials(clients []*ApiClient, rotation CredentialRotation, concurrency int) []*CredentialRotationResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]*CredentialRotationResult, len(clients))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, client := range clients {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, client *ApiClient) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i] = RotateCredential(client, rotation)
+		}(i, client)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// findAccount gets the account with the given user name.
+func findAccount(service *Service, username string) (*redfish.Account, error) {
+	accountService, err := service.AccountService()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := accountService.Accounts()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range accounts {
+		if account.UserName == username {
+			return account, nil
+		}
+	}
+
+	return nil, fmt.Errorf("account %s not found", username)
+}
+
+// verifyCredential creates a session with the credential on a new
+// connection to the service, and logs it out again.
+func verifyCredential(client *ApiClient, service *Service, username, password string) error {
+	verifier := &ApiClient{
+		Endpoint:   client.Endpoint,
+		httpClient: client.httpClient,
+	}
+
+	auth, err := redfish.CreateSession(verifier, service.sessions, username, password)
+	if err != nil {
+		return err
+	}
+
+	// Logging out is best effort, the session will expire on its own.
+	if auth.Session != "" {
+		verifier.Token = auth.Token
+		_ = redfish.DeleteSession(verifier, auth.Session)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+//
+// SPDX-License-Identifier: BSD-3-Clause
+//
+
+package gofish
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeAccountServer is a minimal service with a single account.
+type fakeAccountServer struct {
+	mutex    sync.Mutex
+	password string
+	// rejectLogin makes session creation fail regardless of the password.
+	rejectLogin bool
+	// rejectPasswords lists passwords the service refuses to set.
+	rejectPasswords map[string]bool
+	sessions        int
+}
+
+func (f *fakeAccountServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	switch r.Method + " " + r.URL.Path {
+	case "GET /redfish/v1/":
+		fmt.Fprint(w, `{
+			"AccountService": {"@odata.id": "/redfish/v1/AccountService"},
+			"Links": {"Sessions": {"@odata.id": "/redfish/v1/SessionService/Sessions"}}
+		}`)
+	case "GET /redfish/v1/AccountService":
+		fmt.Fprint(w, `{
+			"@odata.id": "/redfish/v1/AccountService",
+			"Accounts": {"@odata.id": "/redfish/v1/AccountService/Accounts"}
+		}`)
+	case "GET /redfish/v1/AccountService/Accounts":
+		fmt.Fprint(w, `{
+			"Members@odata.count": 2,
+			"Members": [
+				{"@odata.id": "/redfish/v1/AccountService/Accounts/1"},
+				{"@odata.id": "/redfish/v1/AccountService/Accounts/2"}
+			]
+		}`)
+	case "GET /redfish/v1/AccountService/Accounts/1":
+		fmt.Fprint(w, `{"@odata.id": "/redfish/v1/AccountService/Accounts/1", "Id": "1", "UserName": "root", "Password": null}`)
+	case "GET /redfish/v1/AccountService/Accounts/2":
+		fmt.Fprint(w, `{"@odata.id": "/redfish/v1/AccountService/Accounts/2", "Id": "2", "UserName": "ops", "Password": null}`)
+	case "PATCH /redfish/v1/AccountService/Accounts/2":
+		var t struct{ Password string }
+		_ = json.NewDecoder(r.Body).Decode(&t)
+		if f.rejectPasswords[t.Password] {
+			http.Error(w, `{"error": "password rejected"}`, http.StatusBadRequest)
+			return
+		}
+		f.password = t.Password
+		w.WriteHeader(http.StatusNoContent)
+	case "POST /redfish/v1/SessionService/Sessions":
+		var t struct{ UserName, Password string }
+		_ = json.NewDecoder(r.Body).Decode(&t)
+		if f.rejectLogin || t.UserName != "ops" || t.Password != f.password {
+			http.Error(w, `{"error": "unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+		f.sessions++
+		w.Header().Set("X-Auth-Token", "token")
+		w.Header().Set("Location", "/redfish/v1/SessionService/Sessions/1")
+		w.WriteHeader(http.StatusCreated)
+	case "DELETE /redfish/v1/SessionService/Sessions/1":
+		if r.Header.Get("X-Auth-Token") == "token" {
+			f.sessions--
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// TestRotateFleetCredentials tests rotating a credential across several
+// services with different outcomes.
+func TestRotateFleetCredentials(t *testing.T) {
+	fakes := []*fakeAccountServer{
+		{password: "old"},
+		{password: "old", rejectLogin: true},
+		{password: "old", rejectPasswords: map[string]bool{"new": true}},
+	}
+
+	var clients []*ApiClient
+	for _, fake := range fakes {
+		server := httptest.NewServer(fake)
+		defer server.Close()
+
+		client, err := APIClient(server.URL, server.Client())
+		if err != nil {
+			t.Fatalf("Error creating client: %s", err)
+		}
+		clients = append(clients, client)
+	}
+
+	rotation := CredentialRotation{UserName: "ops", OldPassword: "old", NewPassword: "new"}
+	results := RotateFleetCredentials(clients, rotation, 2)
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+
+	if results[0].Outcome != RotatedCredentialRotationOutcome || fakes[0].password != "new" {
+		t.Errorf("Expected rotation, got %s (%s) with password %s", results[0].Outcome, results[0].Error, fakes[0].password)
+	}
+
+	if fakes[0].sessions != 0 {
+		t.Errorf("Verification session was not logged out")
+	}
+
+	if results[0].Account != "/redfish/v1/AccountService/Accounts/2" || results[0].Endpoint != clients[0].Endpoint {
+		t.Errorf("Unexpected result: %v", results[0])
+	}
+
+	if results[1].Outcome != RolledBackCredentialRotationOutcome || fakes[1].password != "old" {
+		t.Errorf("Expected roll back, got %s with password %s", results[1].Outcome, fakes[1].password)
+	}
+
+	if !strings.Contains(results[1].Error, "401") {
+		t.Errorf("Expected the verification error to be recorded: %s", results[1].Error)
+	}
+
+	if results[2].Outcome != FailedCredentialRotationOutcome || fakes[2].password != "old" {
+		t.Errorf("Expected failure, got %s with password %s", results[2].Outcome, fakes[2].password)
+	}
+}
+
+// TestRotateCredentialRollbackFailed tests the outcome when the old password
+// cannot be restored.
+func TestRotateCredentialRollbackFailed(t *testing.T) {
+	fake := &fakeAccountServer{password: "old", rejectLogin: true, rejectPasswords: map[string]bool{"old": true}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := APIClient(server.URL, server.Client())
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	result := RotateCredential(client, CredentialRotation{UserName: "ops", OldPassword: "old", NewPassword: "new"})
+	if result.Outcome != RollbackFailedCredentialRotationOutcome {
+		t.Errorf("Expected rollback failure, got %s", result.Outcome)
+	}
+
+	result = RotateCredential(client, CredentialRotation{UserName: "missing", OldPassword: "old", NewPassword: "new"})
+	if result.Outcome != FailedCredentialRotationOutcome || result.Account != "" {
+		t.Errorf("Expected failure for a missing account, got %s", result.Outcome)
+	}
+}
@@ -57,6 +57,10 @@ type ProtocolFeaturesSupported struct {
 	// SelectQuery shall be a boolean indicating whether this service supports
 	// the use of the $select query parameter as described by the specification.
 	SelectQuery bool
+	// TopSkipQuery shall be a boolean indicating whether this service
+	// supports the use of the $top and $skip query parameters as described by
+	// the specification.
+	TopSkipQuery bool
 }
 
 // Service represents the root Redfish service. All values for resources
@@ -232,6 +236,17 @@ func (serviceroot *Service) Chassis() ([]*redfish.Chassis, error) {
 	return redfish.ListReferencedChassis(serviceroot.Client, serviceroot.chassis)
 }
 
+// ChassisTree gets the chassis managed by this service arranged in their
+// physical containment hierarchy, such as rows, racks, enclosures and blades.
+func (serviceroot *Service) ChassisTree() ([]*redfish.ChassisNode, error) {
+	chassis, err := serviceroot.Chassis()
+	if err != nil {
+		return nil, err
+	}
+
+	return redfish.BuildChassisTree(chassis), nil
+}
+
 // Managers gets the manager instances of this service.
 func (serviceroot *Service) Managers() ([]*redfish.Manager, error) {
 	return redfish.ListReferencedManagers(serviceroot.Client, serviceroot.managers)
@@ -282,7 +297,63 @@ func (serviceroot *Service) Systems() ([]*redfish.ComputerSystem, error) {
 	return redfish.ListReferencedComputerSystems(serviceroot.Client, serviceroot.systems)
 }
 
+// CertificateService gets the certificate service instance
+func (serviceroot *Service) CertificateService() (*redfish.CertificateService, error) {
+	return redfish.GetCertificateService(serviceroot.Client, serviceroot.certificateService)
+}
+
 // CompositionService gets the composition service instance
 func (serviceroot *Service) CompositionService() (*redfish.CompositionService, error) {
 	return redfish.GetCompositionService(serviceroot.Client, serviceroot.compositionService)
 }
+
+// ResourceBlocks gets the resource blocks available for composition
+func (serviceroot *Service) ResourceBlocks() ([]*redfish.ResourceBlock, error) {
+	return redfish.ListReferencedResourceBlocks(serviceroot.Client, serviceroot.resourceBlocks)
+}
+
+// ComposeSystem composes a new system from the given resource blocks
+func (serviceroot *Service) ComposeSystem(name string, blocks []*redfish.ResourceBlock) (*redfish.ComputerSystem, error) {
+	return redfish.ComposeComputerSystem(serviceroot.Client, serviceroot.systems, name, blocks)
+}
+
+// UpdateService gets the update service instance
+func (serviceroot *Service) UpdateService() (*redfish.UpdateService, error) {
+	return redfish.GetUpdateService(serviceroot.Client, serviceroot.updateService)
+}
+
+// TelemetryService gets the telemetry service instance
+func (serviceroot *Service) TelemetryService() (*redfish.TelemetryService, error) {
+	return redfish.GetTelemetryService(serviceroot.Client, serviceroot.telemetryService)
+}
+
+// Registries gets the message registry files published by the service.
+func (serviceroot *Service) Registries() ([]*redfish.MessageRegistryFile, error) {
+	return redfish.ListReferencedMessageRegistryFiles(serviceroot.Client, serviceroot.registries)
+}
+
+// MessageResolver gets a resolver for the MessageIds reported by the
+// service. The resolver caches the registries it fetches, so it should be
+// reused rather than created for each message.
+func (serviceroot *Service) MessageResolver() *redfish.MessageResolver {
+	return redfish.NewMessageResolver(serviceroot.Client, serviceroot.registries)
+}
+
+// LogFollower creates a follower for the entries of the log service, using
+// the query parameters this service supports.
+func (serviceroot *Service) LogFollower(logservice *redfish.LogService, store redfish.LogStateStore) *redfish.LogFollower {
+	follower := redfish.NewLogFollower(logservice, store)
+	follower.FilterQuery = serviceroot.ProtocolFeaturesSupported.FilterQuery
+	follower.TopSkipQuery = serviceroot.ProtocolFeaturesSupported.TopSkipQuery
+	return follower
+}
+
+// JobService gets the job service instance
+func (serviceroot *Service) JobService() (*redfish.JobService, error) {
+	return redfish.GetJobService(serviceroot.Client, serviceroot.jobService)
+}
+
+// Fabrics gets the fabrics of the service.
+func (serviceroot *Service) Fabrics() ([]*redfish.Fabric, error) {
+	return redfish.ListReferencedFabrics(serviceroot.Client, serviceroot.fabrics)
+}
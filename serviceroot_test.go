@@ -62,7 +62,8 @@ var serviceRootBody = strings.NewReader(
 			},
 			"FilterQuery": true,
 			"OnlyMemberQuery": true,
-			"SelectQuery": true
+			"SelectQuery": true,
+			"TopSkipQuery": true
 		},
 		"RedfishVersion": "1.2.3",
 		"Registries": {
@@ -157,6 +158,10 @@ func TestServiceRoot(t *testing.T) {
 		t.Error("ExcerptQuery should be true")
 	}
 
+	if !result.ProtocolFeaturesSupported.TopSkipQuery {
+		t.Error("TopSkipQuery should be true")
+	}
+
 	if result.registries != "/redfish/v1/Registries" {
 		t.Errorf("Invalid Registries link: %s", result.registries)
 	}
@@ -0,0 +1,18 @@
+#!/bin/sh
+# Fetches the standard message registries bundled with the redfish package
+# (redfish/registries) from the DMTF. It replaces the abridged copies
+# described in redfish/registries/NOTICE. Do not edit the fetched files;
+# rerun this script with a newer version instead. The Event registry still
+# has to be added to the list below.
+#
+# Run from this directory. The published registries are listed here:
+#                                       https://redfish.dmtf.org/registries/
+registries="Base.1.8.1 ResourceEvent.1.0.3 TaskEvent.1.0.3"
+dest=../../redfish/registries
+
+for registry in $registries; do
+    echo "Fetching message registry $registry"
+    curl -f -G -L https://redfish.dmtf.org/registries/$registry.json > $dest/$registry.json || exit 1
+done
+
+echo "Check $dest/NOTICE still lists the fetched registries."